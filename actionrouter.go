@@ -22,22 +22,172 @@ func ResEmitFunc(fn func(msg string, success bool)) resEmitFunc {
 
 type RoomAction func(r *Room)
 
-type RoomActionReceiver chan<- RoomAction
+var (
+	ErrRoomNotFound = ActionError{Code: "roomNotFound", Message: "room not found"}
+	ErrRoomExists   = ActionError{Code: "roomExists", Message: "room already exists"}
+)
+
+// RoomActionReceiver runs actions on the goroutine of one room
+type RoomActionReceiver struct {
+	actions chan RoomAction
+	// closed once the room's goroutine stopped taking actions
+	done chan struct{}
+}
+
+// send queues the action in the room, an action sent to a room that
+// closed in the meantime is dropped. It tells if the room took it.
+func (rr *RoomActionReceiver) send(action RoomAction) bool {
+	select {
+	case rr.actions <- action:
+		return true
+	case <-rr.done:
+		return false
+	}
+}
+
+// Notifier pushes room updates to the clients of one transport, every
+// transport gets told about every change so their clients can share rooms.
+type Notifier interface {
+	RoomUpdated(r *Room)
+	RoomEvent(r *Room, event string, args ...interface{})
+	PlayerEvent(r *Room, playerID, event string, args ...interface{})
+	PlayerRemoved(r *Room, playerID string)
+	ChatMessage(r *Room, msg ChatMessage)
+}
+
+// notifiers fans out to all registered transports
+type notifiers []Notifier
+
+func (ns notifiers) RoomUpdated(r *Room) {
+	for _, n := range ns {
+		n.RoomUpdated(r)
+	}
+}
+
+func (ns notifiers) RoomEvent(r *Room, event string, args ...interface{}) {
+	for _, n := range ns {
+		n.RoomEvent(r, event, args...)
+	}
+}
+
+func (ns notifiers) PlayerEvent(r *Room, playerID, event string, args ...interface{}) {
+	for _, n := range ns {
+		n.PlayerEvent(r, playerID, event, args...)
+	}
+}
+
+func (ns notifiers) PlayerRemoved(r *Room, playerID string) {
+	for _, n := range ns {
+		n.PlayerRemoved(r, playerID)
+	}
+}
+
+func (ns notifiers) ChatMessage(r *Room, msg ChatMessage) {
+	for _, n := range ns {
+		n.ChatMessage(r, msg)
+	}
+}
+
+// RouterConfig holds the server wide settings for rooms
+type RouterConfig struct {
+	Store RoomStore
+	Stats *Stats
+
+	// how long a disconnected player keeps their spot in the room
+	ReconnectGrace time.Duration
+	// how long an empty room is kept around before it's closed
+	EmptyRoomGrace time.Duration
+	// how long a player can be inactive before they are kicked,
+	// zero disables afk kicking
+	AfkTimeout time.Duration
+	// how long a changed room waits before it's saved, the changes
+	// made in the meantime are written together
+	SaveDelay time.Duration
+}
+
+const defaultSaveDelay = 5 * time.Second
 
 type ActionRouter struct {
 	sync.RWMutex
-	playerRooms map[string]RoomActionReceiver
-	nameRooms   map[string]RoomActionReceiver
+	playerRooms map[string]*RoomActionReceiver
+	nameRooms   map[string]*RoomActionReceiver
+
+	store    RoomStore
+	stats    *Stats
+	notifier notifiers
+	config   RouterConfig
+	afk      *afkTracker
+
+	// pending removals, guarded separately so timers never wait
+	// on a room goroutine while holding the router lock
+	pending       sync.Mutex
+	pendingLeaves map[string]*time.Timer
+	pendingCloses map[string]*time.Timer
 }
 
-func NewActionRouter() *ActionRouter {
-	return &ActionRouter{
-		playerRooms: map[string]RoomActionReceiver{},
-		nameRooms:   map[string]RoomActionReceiver{},
+func NewActionRouter(config RouterConfig) *ActionRouter {
+	if config.Store == nil {
+		config.Store = nopRoomStore{}
+	}
+	if config.Stats == nil {
+		config.Stats = NewStats(nopStatsStore{})
 	}
+	if config.SaveDelay <= 0 {
+		config.SaveDelay = defaultSaveDelay
+	}
+	a := &ActionRouter{
+		playerRooms:   map[string]*RoomActionReceiver{},
+		nameRooms:     map[string]*RoomActionReceiver{},
+		store:         config.Store,
+		stats:         config.Stats,
+		config:        config,
+		pendingLeaves: map[string]*time.Timer{},
+		pendingCloses: map[string]*time.Timer{},
+	}
+	a.afk = newAfkTracker(config.AfkTimeout, a.warnAfk, a.kickAfk)
+	a.restoreRooms()
+	return a
 }
 
-func (a *ActionRouter) PlayerRoomReceiver(playerID string) RoomActionReceiver {
+// AddNotifier registers a transport, it has to be called before
+// any client connects.
+func (a *ActionRouter) AddNotifier(n Notifier) {
+	a.notifier = append(a.notifier, n)
+}
+
+// restoreRooms starts a router for every room in the store and
+// maps the players back to their rooms.
+func (a *ActionRouter) restoreRooms() {
+	rooms, err := a.store.Load()
+	if err != nil {
+		log.WithField("Error", err).Warn("unable to load rooms from store")
+		return
+	}
+
+	for _, r := range rooms {
+		if len(r.Players) == 0 {
+			a.store.Delete(r.Name)
+			continue
+		}
+
+		rr := a.startRoomRouter(r)
+		a.nameRooms[r.Name] = rr
+		for playerID, p := range r.Players {
+			a.playerRooms[playerID] = rr
+
+			// nobody is connected yet, give everyone a chance to come back
+			p.Away = true
+			a.scheduleLeave(playerID)
+		}
+
+		log.WithFields(logrus.Fields{
+			"RoomName": r.Name,
+			"Players":  len(r.Players),
+		}).Info("restored room")
+	}
+}
+
+func (a *ActionRouter) PlayerRoomReceiver(playerID string) *RoomActionReceiver {
 	a.RLock()
 	defer a.RUnlock()
 	if rr, ok := a.playerRooms[playerID]; ok {
@@ -46,7 +196,7 @@ func (a *ActionRouter) PlayerRoomReceiver(playerID string) RoomActionReceiver {
 	return nil
 }
 
-func (a *ActionRouter) RoomNameReceiver(roomName string) RoomActionReceiver {
+func (a *ActionRouter) RoomNameReceiver(roomName string) *RoomActionReceiver {
 	a.RLock()
 	defer a.RUnlock()
 	if rr, ok := a.nameRooms[roomName]; ok {
@@ -56,7 +206,24 @@ func (a *ActionRouter) RoomNameReceiver(roomName string) RoomActionReceiver {
 
 }
 
-func (a *ActionRouter) CreateRoom(playerID, nick, room, password string, res ResponseEmitter) {
+func (a *ActionRouter) CreateRoom(playerID, addr, nick, room, password string, res ResponseEmitter) {
+	a.createRoom(playerID, addr, nick, room, password, nil, res)
+}
+
+// CreateReplayRoom creates a room that replays an exported game
+func (a *ActionRouter) CreateReplayRoom(playerID, addr, nick, room, password string, rec *GameRecord, res ResponseEmitter) {
+	if err := rec.validate(); err != nil {
+		res.Emit(err.Error(), false)
+		return
+	}
+	a.createRoom(playerID, addr, nick, room, password, func(r *Room) {
+		r.startReplay(rec)
+	}, res)
+}
+
+// createRoom creates the room with the player as its host, setup runs
+// in the room before the player joins.
+func (a *ActionRouter) createRoom(playerID, addr, nick, room, password string, setup RoomAction, res ResponseEmitter) {
 	if len(nick) == 0 {
 		res.Emit("invalid nickname", false)
 		return
@@ -66,6 +233,14 @@ func (a *ActionRouter) CreateRoom(playerID, nick, room, password string, res Res
 		res.Emit("invalid password", false)
 	}
 
+	if a.RoomNameReceiver(room) != nil {
+		res.Emit(fmt.Sprintf("room %s already exists.", room), false)
+		return
+	}
+
+	// check if player is an another room
+	a.LeaveRoom(playerID, a.notifier.RoomUpdated)
+
 	// this actions needs to be atomic
 	a.Lock()
 
@@ -76,19 +251,18 @@ func (a *ActionRouter) CreateRoom(playerID, nick, room, password string, res Res
 		return
 	}
 
-	// check if player is an another room
-	if rr, ok := a.playerRooms[playerID]; ok {
-		rr <- func(r *Room) {
-			r.Leave(playerID)
-		}
-	}
-
 	r := NewRoom(room, password)
-	rr := startRoomRouter(r)
+	rr := a.startRoomRouter(r)
 
-	rr <- func(r *Room) {
+	rr.send(func(r *Room) {
+		if setup != nil {
+			setup(r)
+		}
 		r.Join(playerID, nick)
-	}
+		r.Players[playerID].addr = addr
+		r.Host = playerID
+		a.touch(r, playerID)
+	})
 
 	a.playerRooms[playerID] = rr
 	a.nameRooms[room] = rr
@@ -98,7 +272,9 @@ func (a *ActionRouter) CreateRoom(playerID, nick, room, password string, res Res
 	res.Emit("created the room", true)
 }
 
-func (a *ActionRouter) JoinRoom(playerID, nick, roomName, password string, action RoomAction) bool {
+// JoinRoom adds the player to the room, addr is where they connect
+// from so a ban keeps out their other sessions too.
+func (a *ActionRouter) JoinRoom(playerID, addr, nick, roomName, password string, action RoomAction) bool {
 	rr := a.RoomNameReceiver(roomName)
 	if rr == nil {
 		log.WithFields(logrus.Fields{
@@ -108,27 +284,31 @@ func (a *ActionRouter) JoinRoom(playerID, nick, roomName, password string, actio
 		}).Info("player tried to join a nonexistant room")
 		return false
 	}
+	a.cancelClose(roomName)
 
-	rr <- func(r *Room) {
-		if r.Password != password {
+	return rr.send(func(r *Room) {
+		if !checkPassword(r.Password, password) || r.IsBanned(playerID, addr) {
 			action(nil)
 			return
 		}
 
 		r.Join(playerID, nick)
+		r.Players[playerID].addr = addr
+		a.touch(r, playerID)
 		a.Lock()
-		defer a.Unlock()
 		a.playerRooms[playerID] = rr
+		a.Unlock()
 
 		action(r)
-	}
-	return true
+	})
 }
 
 func (a *ActionRouter) RoomForPlayer(playerID string, action RoomAction) bool {
 	if rr := a.PlayerRoomReceiver(playerID); rr != nil {
-		rr <- action
-		return true
+		return rr.send(func(r *Room) {
+			a.touch(r, playerID)
+			action(r)
+		})
 	}
 	log.WithField("PlayerID", playerID).Warn("player not in any room, dropping action")
 	return false
@@ -136,21 +316,168 @@ func (a *ActionRouter) RoomForPlayer(playerID string, action RoomAction) bool {
 
 func (a *ActionRouter) RoomByName(roomName string, action RoomAction) bool {
 	if rr := a.RoomNameReceiver(roomName); rr != nil {
-		rr <- action
-		return true
+		return rr.send(action)
 	}
 	log.WithField("RoomName", roomName).Warn("room not found, dropping action")
 	return false
 }
 
-func startRoomRouter(r *Room) RoomActionReceiver {
-	actionChan := make(chan RoomAction)
+// InspectRoom runs the action in the room and waits for it to finish
+func (a *ActionRouter) InspectRoom(roomName string, action RoomAction) bool {
+	done := make(chan struct{})
+	ok := a.RoomByName(roomName, func(r *Room) {
+		defer close(done)
+		action(r)
+	})
+	if ok {
+		<-done
+	}
+	return ok
+}
+
+// RoomNames lists the open rooms
+func (a *ActionRouter) RoomNames() []string {
+	a.RLock()
+	defer a.RUnlock()
+	names := []string{}
+	for name := range a.nameRooms {
+		names = append(names, name)
+	}
+	return names
+}
+
+// OpenRoom creates a room without any players in it, it's closed
+// like any other empty room if nobody joins.
+func (a *ActionRouter) OpenRoom(name, password string) error {
+	a.Lock()
+	if _, ok := a.nameRooms[name]; ok {
+		a.Unlock()
+		return ErrRoomExists
+	}
+	rr := a.startRoomRouter(NewRoom(name, password))
+	a.nameRooms[name] = rr
+	a.Unlock()
+
+	rr.send(func(r *Room) {
+		if len(r.Players) == 0 {
+			a.closeRoomLater(r)
+		}
+	})
+	return nil
+}
+
+// CloseRoom removes every player from the room and closes it right away
+func (a *ActionRouter) CloseRoom(name string) bool {
+	a.cancelClose(name)
+
+	return a.RoomByName(name, func(r *Room) {
+		for id := range r.Players {
+			a.notifier.PlayerEvent(r, id, "serverMessage", serverMessage{Message: "The room was closed"})
+			a.notifier.PlayerRemoved(r, id)
+			a.removePlayer(r, id)
+		}
+		a.cancelClose(r.Name)
+		a.closeRoom(r)
+	})
+}
+
+// startRoomRouter runs the room's actions one at a time and ticks its
+// timer in between while the room is in timed mode, proposals waiting
+// to be auto-committed are flipped in between too. A changed room is
+// saved once the save delay passed.
+func (a *ActionRouter) startRoomRouter(r *Room) *RoomActionReceiver {
+	rr := &RoomActionReceiver{
+		actions: make(chan RoomAction),
+		done:    make(chan struct{}),
+	}
 	go func() {
-		for action := range actionChan {
-			action(r)
+		timer := newTurnTimer()
+		defer timer.stop()
+		commit := &deadline{}
+		defer commit.stop()
+		save := &deadline{}
+		defer save.stop()
+		dirty := false
+
+	loop:
+		for {
+			timer.set(r.timerRunning())
+			commit.set(r.commitDue())
+
+			select {
+			case action := <-rr.actions:
+				action(r)
+				dirty = true
+			case <-timer.C():
+				timer.next()
+				// a tick only changes the clock, the room is saved
+				// once the turn is over
+				if a.tickTimer(r) {
+					dirty = true
+				}
+			case <-commit.C():
+				commit.fired()
+				r.CommitProposal()
+				a.notifier.RoomUpdated(r)
+				dirty = true
+			case <-save.C():
+				save.fired()
+				a.saveRoom(r)
+				dirty = false
+			}
+
+			for _, msg := range r.takeAnnouncements() {
+				a.notifier.ChatMessage(r, msg)
+			}
+			results, undone := r.takeResults()
+			for _, res := range results {
+				a.stats.Record(res)
+			}
+			for _, id := range undone {
+				a.stats.Forget(id)
+			}
+
+			select {
+			case <-rr.done:
+				break loop
+			default:
+			}
+			if dirty && save.C() == nil {
+				save.set(time.Now().Add(a.config.SaveDelay), true)
+			}
+		}
+
+		if err := a.store.Delete(r.Name); err != nil {
+			log.WithFields(logrus.Fields{
+				"RoomName": r.Name,
+				"Error":    err,
+			}).Warn("unable to delete closed room")
 		}
 	}()
-	return actionChan
+	return rr
+}
+
+func (a *ActionRouter) saveRoom(r *Room) {
+	if err := a.store.Save(r); err != nil {
+		log.WithFields(logrus.Fields{
+			"RoomName": r.Name,
+			"Error":    err,
+		}).Warn("unable to save room")
+	}
+}
+
+// tickTimer counts the room's timer down and tells the clients, they
+// get the whole room when the turn ran out. It tells if the turn ended.
+func (a *ActionRouter) tickTimer(r *Room) bool {
+	ended := r.TimerTick()
+	if ended {
+		a.notifier.RoomUpdated(r)
+	}
+	a.notifier.RoomEvent(r, "timerUpdate", timerUpdateMessage{
+		Timer: r.Game.Timer,
+		Bank:  r.Game.Bank,
+	})
+	return ended
 }
 
 func (a *ActionRouter) CheckIfPlayerExists(playerID string, res func(players, rooms int, playerID string, isInRoom bool, gs gameState)) {
@@ -160,54 +487,220 @@ func (a *ActionRouter) CheckIfPlayerExists(playerID string, res func(players, ro
 		return
 	}
 
-	rr <- func(r *Room) {
-		res(a.Players(), a.Rooms(), playerID, true, r.GameState())
+	ok := rr.send(func(r *Room) {
+		res(a.Players(), a.Rooms(), playerID, true, r.GameStateFor(playerID))
+	})
+	if !ok {
+		res(a.Players(), a.Rooms(), playerID, false, gameState{})
 	}
 }
 
-func (a *ActionRouter) TimedPlayerRoomAction(playerID string, action func(*Room) bool) {
-	go func() {
-		ticker := time.NewTicker(1 * time.Second)
-		for {
-			<-ticker.C
-			rr := a.PlayerRoomReceiver(playerID)
-			if rr == nil {
-				ticker.Stop()
-				log.WithField("PlayerID", playerID).Warn("could not setup timed action, player not in any room")
-				return
-			}
+func (a *ActionRouter) LeaveRoom(playerID string, action RoomAction) bool {
+	a.cancelLeave(playerID)
 
-			rr <- func(r *Room) {
-				if !action(r) {
-					ticker.Stop()
-					return
-				}
+	rr := a.PlayerRoomReceiver(playerID)
+	if rr == nil {
+		return false
+	}
+
+	return rr.send(func(r *Room) {
+		a.removePlayer(r, playerID)
+		action(r)
+	})
+}
+
+// removePlayer takes the player out of the room and the router,
+// it must be called from the room's own goroutine.
+func (a *ActionRouter) removePlayer(r *Room, playerID string) {
+	a.cancelLeave(playerID)
+	a.afk.Forget(playerID)
+
+	r.Leave(playerID)
+
+	// the player may have gone on to another room before this ran
+	a.Lock()
+	if a.playerRooms[playerID] == a.nameRooms[r.Name] {
+		delete(a.playerRooms, playerID)
+	}
+	a.Unlock()
+
+	if len(r.Players) == 0 {
+		a.closeRoomLater(r)
+	}
+}
+
+// Kick lets the host remove a player from their room, banned players
+// can't join the room again.
+func (a *ActionRouter) Kick(hostID, targetID string, ban bool, res func(r *Room, err error)) bool {
+	return a.RoomForPlayer(hostID, func(r *Room) {
+		if err := r.Kick(hostID, targetID, ban); err != nil {
+			res(r, err)
+			return
+		}
+
+		if _, ok := r.Player(targetID); ok {
+			msg := "You were kicked from the room"
+			if ban {
+				msg = "You were banned from the room"
 			}
+			a.notifier.PlayerEvent(r, targetID, "serverMessage", serverMessage{Message: msg})
+			a.notifier.PlayerRemoved(r, targetID)
+			a.removePlayer(r, targetID)
 		}
-	}()
+		res(r, nil)
+	})
 }
 
-func (a *ActionRouter) LeaveRoom(playerID string, action RoomAction) bool {
-	a.Lock()
-	defer a.Unlock()
+// Disconnect marks the player as away, they are removed from the room
+// if they don't reconnect within the grace period.
+func (a *ActionRouter) Disconnect(playerID string) bool {
+	if a.config.ReconnectGrace <= 0 {
+		return a.LeaveRoom(playerID, a.notifier.RoomUpdated)
+	}
 
-	if rr, ok := a.playerRooms[playerID]; ok {
-		rr <- action
-		rr <- func(r *Room) {
+	rr := a.PlayerRoomReceiver(playerID)
+	if rr == nil {
+		return false
+	}
 
-			r.Leave(playerID)
-			delete(a.playerRooms, playerID)
+	if !rr.send(func(r *Room) {
+		r.SetAway(playerID, true)
+		a.notifier.RoomUpdated(r)
+	}) {
+		return false
+	}
+	a.scheduleLeave(playerID)
+	return true
+}
 
-			// todo(voldy): delay this for later?
+// Reconnect cancels the pending removal of a player who came back
+func (a *ActionRouter) Reconnect(playerID string, action RoomAction) bool {
+	a.cancelLeave(playerID)
+
+	rr := a.PlayerRoomReceiver(playerID)
+	if rr == nil {
+		return false
+	}
+
+	return rr.send(func(r *Room) {
+		r.SetAway(playerID, false)
+		a.touch(r, playerID)
+		action(r)
+	})
+}
+
+// touch restarts the afk clock for a player, it must be called from
+// the room's own goroutine.
+func (a *ActionRouter) touch(r *Room, playerID string) {
+	if !a.afk.Enabled() {
+		return
+	}
+	a.afk.Touch(playerID)
+
+	timeout := int(a.config.AfkTimeout.Seconds())
+	r.SetAfkTimer(playerID, timeout, timeout)
+}
+
+func (a *ActionRouter) warnAfk(playerID string) {
+	rr := a.PlayerRoomReceiver(playerID)
+	if rr == nil {
+		return
+	}
+
+	rr.send(func(r *Room) {
+		r.SetAfkTimer(playerID, int(a.config.AfkTimeout.Seconds()), int(afkWarning.Seconds()))
+		a.notifier.PlayerEvent(r, playerID, "afkWarning")
+	})
+}
+
+func (a *ActionRouter) kickAfk(playerID string) {
+	log.WithField("PlayerID", playerID).Info("kicking afk player")
+
+	a.LeaveRoom(playerID, func(r *Room) {
+		a.notifier.PlayerEvent(r, playerID, "afkKicked")
+		a.notifier.PlayerRemoved(r, playerID)
+		a.notifier.RoomUpdated(r)
+	})
+}
+
+func (a *ActionRouter) scheduleLeave(playerID string) {
+	a.pending.Lock()
+	defer a.pending.Unlock()
+
+	if t, ok := a.pendingLeaves[playerID]; ok {
+		t.Stop()
+	}
+	a.pendingLeaves[playerID] = time.AfterFunc(a.config.ReconnectGrace, func() {
+		log.WithField("PlayerID", playerID).Info("player did not reconnect in time")
+		a.LeaveRoom(playerID, a.notifier.RoomUpdated)
+	})
+}
+
+func (a *ActionRouter) cancelLeave(playerID string) {
+	a.pending.Lock()
+	defer a.pending.Unlock()
+
+	if t, ok := a.pendingLeaves[playerID]; ok {
+		t.Stop()
+		delete(a.pendingLeaves, playerID)
+	}
+}
+
+// closeRoomLater closes an empty room once the grace period is over,
+// it must be called from the room's own goroutine.
+func (a *ActionRouter) closeRoomLater(r *Room) {
+	if a.config.EmptyRoomGrace <= 0 {
+		a.closeRoom(r)
+		return
+	}
+
+	name := r.Name
+	log.WithFields(logrus.Fields{
+		"RoomName": name,
+		"Grace":    a.config.EmptyRoomGrace,
+	}).Info("all players left the room, closing it later")
+
+	a.pending.Lock()
+	defer a.pending.Unlock()
+
+	if t, ok := a.pendingCloses[name]; ok {
+		t.Stop()
+	}
+	a.pendingCloses[name] = time.AfterFunc(a.config.EmptyRoomGrace, func() {
+		a.pending.Lock()
+		delete(a.pendingCloses, name)
+		a.pending.Unlock()
+
+		a.RoomByName(name, func(r *Room) {
 			if len(r.Players) == 0 {
-				close(a.nameRooms[r.Name])
-				delete(a.nameRooms, r.Name)
+				a.closeRoom(r)
 			}
-		}
+		})
+	})
+}
 
-		return true
+func (a *ActionRouter) cancelClose(roomName string) {
+	a.pending.Lock()
+	defer a.pending.Unlock()
+
+	if t, ok := a.pendingCloses[roomName]; ok {
+		t.Stop()
+		delete(a.pendingCloses, roomName)
+	}
+}
+
+// closeRoom stops the room's router, it must be called from the
+// room's own goroutine. Actions sent to it after that are dropped.
+func (a *ActionRouter) closeRoom(r *Room) {
+	a.Lock()
+	rr, ok := a.nameRooms[r.Name]
+	delete(a.nameRooms, r.Name)
+	a.Unlock()
+
+	if ok {
+		log.WithField("RoomName", r.Name).Info("closing room")
+		close(rr.done)
 	}
-	return false
 }
 
 func (a *ActionRouter) Players() int {
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// afkWarning is how long before being kicked a player gets warned
+const afkWarning = 5 * time.Minute
+
+// afkTracker warns and then kicks players who haven't done anything
+// for the configured timeout, every action resets the clock.
+type afkTracker struct {
+	sync.Mutex
+	timeout  time.Duration
+	timers   map[string][]*time.Timer
+	lastSeen map[string]time.Time
+
+	warn func(playerID string)
+	kick func(playerID string)
+}
+
+func newAfkTracker(timeout time.Duration, warn, kick func(playerID string)) *afkTracker {
+	return &afkTracker{
+		timeout:  timeout,
+		timers:   map[string][]*time.Timer{},
+		lastSeen: map[string]time.Time{},
+		warn:     warn,
+		kick:     kick,
+	}
+}
+
+func (t *afkTracker) Enabled() bool {
+	return t.timeout > 0
+}
+
+// Touch restarts the clock for a player
+func (t *afkTracker) Touch(playerID string) {
+	if !t.Enabled() {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.stop(playerID)
+	t.lastSeen[playerID] = time.Now()
+
+	timers := []*time.Timer{
+		time.AfterFunc(t.timeout, func() {
+			if t.idle(playerID, t.timeout, true) {
+				t.kick(playerID)
+			}
+		}),
+	}
+	if t.timeout > afkWarning {
+		timers = append(timers, time.AfterFunc(t.timeout-afkWarning, func() {
+			if t.idle(playerID, t.timeout-afkWarning, false) {
+				t.warn(playerID)
+			}
+		}))
+	}
+	t.timers[playerID] = timers
+}
+
+// idle tells if the player did nothing for the duration, a timer that
+// fires while the player is being touched finds them active. The
+// player is forgotten when forget is set and they were idle.
+func (t *afkTracker) idle(playerID string, d time.Duration, forget bool) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	seen, ok := t.lastSeen[playerID]
+	if !ok || time.Since(seen) < d {
+		return false
+	}
+	if forget {
+		t.stop(playerID)
+	}
+	return true
+}
+
+// Forget stops tracking a player, usually because they left
+func (t *afkTracker) Forget(playerID string) {
+	t.Lock()
+	defer t.Unlock()
+	t.stop(playerID)
+}
+
+func (t *afkTracker) stop(playerID string) {
+	for _, timer := range t.timers[playerID] {
+		timer.Stop()
+	}
+	delete(t.timers, playerID)
+	delete(t.lastSeen, playerID)
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	apiRoomsPath = "/api/rooms"
+	apiStatsPath = "/api/stats"
+)
+
+var (
+	ErrAPIDisabled      = ActionError{Code: "apiDisabled", Message: "the api is disabled, start the server with -api-token"}
+	ErrUnauthorized     = ActionError{Code: "unauthorized", Message: "missing or wrong api token"}
+	ErrNotFound         = ActionError{Code: "notFound", Message: "not found"}
+	ErrMethodNotAllowed = ActionError{Code: "methodNotAllowed", Message: "method not allowed"}
+	ErrInvalidRoom      = ActionError{Code: "invalidRoom", Message: "a room needs a name and a password"}
+)
+
+type apiRoomSummary struct {
+	Name       string `json:"name"`
+	Players    int    `json:"players"`
+	Connected  int    `json:"connected"`
+	GameType   string `json:"gameType"`
+	Difficulty string `json:"difficulty"`
+	Mode       string `json:"mode"`
+	Over       bool   `json:"over"`
+}
+
+type apiPlayer struct {
+	Nickname string `json:"nickname"`
+	Team     string `json:"team"`
+	Role     string `json:"role"`
+	Away     bool   `json:"away"`
+	Host     bool   `json:"host"`
+}
+
+// apiRoomState is a room as a spectator sees it, player ids are left
+// out since nobody outside the room needs them.
+type apiRoomState struct {
+	Name       string      `json:"name"`
+	Difficulty string      `json:"difficulty"`
+	Mode       string      `json:"mode"`
+	Consensus  string      `json:"consensus"`
+	GameType   string      `json:"gameType"`
+	Locked     bool        `json:"locked"`
+	Players    []apiPlayer `json:"players"`
+	Game       *Game       `json:"game"`
+}
+
+type createRoomAPIRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// apiServer serves the REST API for admin tools and integrations that
+// don't want to keep a socket open. Every request needs the api token
+// as a bearer token, the api is off when no token is set.
+//
+//	GET    /api/rooms                 list rooms
+//	POST   /api/rooms                 create an empty room
+//	GET    /api/rooms/{name}          the room as a spectator sees it
+//	DELETE /api/rooms/{name}          remove all players and close the room
+//	GET    /api/rooms/{name}/log      download the log of the current game
+//	GET    /api/rooms/{name}/history  download every game played in the room
+//	GET    /api/rooms/{name}/stats    the leaderboard of the room
+//	GET    /api/stats                 the leaderboard of the whole server
+type apiServer struct {
+	router *ActionRouter
+	token  string
+}
+
+func newAPIServer(a *ActionRouter, token string) *apiServer {
+	return &apiServer{
+		router: a,
+		token:  token,
+	}
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	log.WithFields(logrus.Fields{
+		"Method": req.Method,
+		"Path":   req.URL.Path,
+	}).Info("received api request")
+
+	if len(s.token) == 0 {
+		writeAPIError(w, http.StatusForbidden, ErrAPIDisabled)
+		return
+	}
+	if !s.authorized(req) {
+		writeAPIError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return
+	}
+
+	if req.URL.Path == apiStatsPath {
+		if req.Method != http.MethodGet {
+			writeAPIError(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, s.router.stats.Leaderboard(""))
+		return
+	}
+
+	path, err := apiPath(req.URL)
+	if err != nil {
+		writeAPIError(w, http.StatusNotFound, ErrNotFound)
+		return
+	}
+
+	switch {
+	case len(path) == 0 && req.Method == http.MethodGet:
+		s.listRooms(w)
+	case len(path) == 0 && req.Method == http.MethodPost:
+		s.createRoom(w, req)
+	case len(path) == 1 && req.Method == http.MethodGet:
+		s.getRoom(w, path[0])
+	case len(path) == 1 && req.Method == http.MethodDelete:
+		s.closeRoom(w, path[0])
+	case len(path) == 2 && path[1] == "log" && req.Method == http.MethodGet:
+		s.roomLog(w, path[0])
+	case len(path) == 2 && path[1] == "history" && req.Method == http.MethodGet:
+		s.roomHistory(w, path[0])
+	case len(path) == 2 && path[1] == "stats" && req.Method == http.MethodGet:
+		writeAPIResponse(w, http.StatusOK, s.router.stats.Leaderboard(path[0]))
+	case len(path) <= 2:
+		writeAPIError(w, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+	default:
+		writeAPIError(w, http.StatusNotFound, ErrNotFound)
+	}
+}
+
+func (s *apiServer) authorized(req *http.Request) bool {
+	expected := []byte("Bearer " + s.token)
+	got := []byte(req.Header.Get("Authorization"))
+	return subtle.ConstantTimeCompare(expected, got) == 1
+}
+
+// apiPath splits the path below /api/rooms, room names are unescaped
+// so they may contain slashes.
+func apiPath(u *url.URL) ([]string, error) {
+	rest := strings.TrimPrefix(u.EscapedPath(), apiRoomsPath)
+	rest = strings.Trim(rest, "/")
+	if len(rest) == 0 {
+		return nil, nil
+	}
+
+	parts := strings.Split(rest, "/")
+	for i, p := range parts {
+		unescaped, err := url.PathUnescape(p)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = unescaped
+	}
+	return parts, nil
+}
+
+func (s *apiServer) listRooms(w http.ResponseWriter) {
+	names := s.router.RoomNames()
+	sort.Strings(names)
+
+	rooms := []apiRoomSummary{}
+	for _, name := range names {
+		s.router.InspectRoom(name, func(r *Room) {
+			rooms = append(rooms, roomSummary(r))
+		})
+	}
+	writeAPIResponse(w, http.StatusOK, rooms)
+}
+
+func (s *apiServer) createRoom(w http.ResponseWriter, req *http.Request) {
+	var body createRoomAPIRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeAPIError(w, http.StatusBadRequest, ErrBadPayload)
+		return
+	}
+	if len(body.Name) == 0 || len(body.Password) == 0 {
+		writeAPIError(w, http.StatusBadRequest, ErrInvalidRoom)
+		return
+	}
+
+	if err := s.router.OpenRoom(body.Name, body.Password); err != nil {
+		writeAPIError(w, http.StatusConflict, err)
+		return
+	}
+
+	var summary apiRoomSummary
+	s.router.InspectRoom(body.Name, func(r *Room) {
+		summary = roomSummary(r)
+	})
+	writeAPIResponse(w, http.StatusCreated, summary)
+}
+
+func (s *apiServer) getRoom(w http.ResponseWriter, name string) {
+	var state apiRoomState
+	if !s.router.InspectRoom(name, func(r *Room) {
+		state = redactedRoomState(r)
+	}) {
+		writeAPIError(w, http.StatusNotFound, ErrRoomNotFound)
+		return
+	}
+	writeAPIResponse(w, http.StatusOK, state)
+}
+
+func (s *apiServer) closeRoom(w http.ResponseWriter, name string) {
+	if !s.router.CloseRoom(name) {
+		writeAPIError(w, http.StatusNotFound, ErrRoomNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *apiServer) roomLog(w http.ResponseWriter, name string) {
+	var entries []GameLog
+	if !s.router.InspectRoom(name, func(r *Room) {
+		entries = append([]GameLog{}, r.Game.Log...)
+	}) {
+		writeAPIError(w, http.StatusNotFound, ErrRoomNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+"-log.json"))
+	writeAPIResponse(w, http.StatusOK, entries)
+}
+
+// roomHistory returns the records of the room's games, the current
+// game is the last one once it's over. Records have the whole key so
+// a game still being played is left out.
+func (s *apiServer) roomHistory(w http.ResponseWriter, name string) {
+	var records []*GameRecord
+	if !s.router.InspectRoom(name, func(r *Room) {
+		records = append([]*GameRecord{}, r.History...)
+		if r.Replay == nil && r.Game.Over {
+			records = append(records, r.Game.Record())
+		}
+	}) {
+		writeAPIError(w, http.StatusNotFound, ErrRoomNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+"-history.json"))
+	writeAPIResponse(w, http.StatusOK, records)
+}
+
+func roomSummary(r *Room) apiRoomSummary {
+	connected := 0
+	for _, p := range r.Players {
+		if !p.Away {
+			connected++
+		}
+	}
+	return apiRoomSummary{
+		Name:       r.Name,
+		Players:    len(r.Players),
+		Connected:  connected,
+		GameType:   r.GameType,
+		Difficulty: r.Difficulty,
+		Mode:       r.Mode,
+		Over:       r.Game.Over,
+	}
+}
+
+func redactedRoomState(r *Room) apiRoomState {
+	// nobody has an empty id, so this is the spectator's view of the game
+	gs := r.GameStateFor("")
+
+	players := []apiPlayer{}
+	for id, p := range r.Players {
+		players = append(players, apiPlayer{
+			Nickname: p.NickName,
+			Team:     p.Team,
+			Role:     p.Role,
+			Away:     p.Away,
+			Host:     r.IsHost(id),
+		})
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].Nickname < players[j].Nickname })
+
+	return apiRoomState{
+		Name:       r.Name,
+		Difficulty: r.Difficulty,
+		Mode:       r.Mode,
+		Consensus:  r.Consesus,
+		GameType:   r.GameType,
+		Locked:     r.SettingsLocked,
+		Players:    players,
+		Game:       gs.Game,
+	}
+}
+
+func writeAPIResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithField("Error", err).Warn("unable to write api response")
+	}
+}
+
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	msg := newActionErrorMessage("", err)
+	writeAPIResponse(w, status, ActionError{Code: msg.Code, Message: msg.Message})
+}
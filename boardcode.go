@@ -0,0 +1,76 @@
+package main
+
+import (
+	"math/rand"
+	"strings"
+)
+
+const (
+	// board codes are the game's seed in crockford base32, 8 characters
+	// hold 40 bits
+	boardCodeLength   = 8
+	boardCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var ErrBoardCode = ActionError{Code: "invalidBoardCode", Message: "that's not a board code"}
+
+// newSeed picks the seed of a new game, every seed has a board code
+func newSeed() int64 {
+	return rand.Int63n(1 << (5 * boardCodeLength))
+}
+
+// seedStartedBy picks a seed whose board the team starts, half of
+// all seeds are
+func seedStartedBy(team string) int64 {
+	for {
+		seed := newSeed()
+		if firstTurn(rand.New(rand.NewSource(seed))) == team {
+			return seed
+		}
+	}
+}
+
+// boardCode is the short code players share to deal the same board in
+// another room, it only gives the same board with the same word packs,
+// difficulty and game type.
+func boardCode(seed int64) string {
+	code := make([]byte, boardCodeLength)
+	for i := boardCodeLength - 1; i >= 0; i-- {
+		code[i] = boardCodeAlphabet[seed&31]
+		seed >>= 5
+	}
+	return string(code)
+}
+
+// parseBoardCode reads a code back into a seed, it ignores case and
+// dashes and reads the letters that are easily mistaken for digits
+// as those digits.
+func parseBoardCode(code string) (int64, error) {
+	code = strings.ToUpper(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+	if len(code) != boardCodeLength {
+		return 0, ErrBoardCode
+	}
+	code = strings.NewReplacer("O", "0", "I", "1", "L", "1").Replace(code)
+
+	var seed int64
+	for _, c := range code {
+		i := strings.IndexRune(boardCodeAlphabet, c)
+		if i < 0 {
+			return 0, ErrBoardCode
+		}
+		seed = seed<<5 | int64(i)
+	}
+	return seed, nil
+}
+
+// NewGameFromCode starts a game on the board of a shared code
+func (r *Room) NewGameFromCode(playerID, code string) error {
+	if err := r.authorize(playerID, ActionNewGame); err != nil {
+		return err
+	}
+	seed, err := parseBoardCode(code)
+	if err != nil {
+		return err
+	}
+	return r.startGameWithRoles(seed)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"strings"
+	"time"
+)
+
+var (
+	ChatScopeAll        = "all"
+	ChatScopeTeam       = "team"
+	ChatScopeSpyMasters = "spymasters"
+	ChatScopes          = buildSet(ChatScopeAll, ChatScopeTeam, ChatScopeSpyMasters)
+)
+
+const (
+	chatHistorySize   = 100
+	chatMessageLength = 500
+)
+
+var (
+	ErrInvalidChatScope = ActionError{Code: "invalidScope", Message: "invalid chat channel"}
+	ErrEmptyChat        = ActionError{Code: "emptyMessage", Message: "message is empty"}
+	ErrChatTooLong      = ActionError{Code: "messageTooLong", Message: "message is too long"}
+	ErrNotSpyMaster     = ActionError{Code: "notSpyMaster", Message: "only spymasters can use the spymaster channel"}
+)
+
+type ChatMessage struct {
+	PlayerID string    `json:"playerId"`
+	NickName string    `json:"nickname"`
+	Team     string    `json:"team"`
+	Role     string    `json:"role"`
+	Scope    string    `json:"scope"`
+	Text     string    `json:"text"`
+	Time     time.Time `json:"time"`
+
+	// System messages come from the server, not a player
+	System bool `json:"system,omitempty"`
+}
+
+// VisibleTo tells if the player may read the message, team messages
+// stay within the sender's team and spymaster messages with spymasters.
+func (m ChatMessage) VisibleTo(p *Player) bool {
+	if p == nil {
+		return false
+	}
+	switch m.Scope {
+	case ChatScopeTeam:
+		return p.Team == m.Team
+	case ChatScopeSpyMasters:
+		return p.Role == PlayerRoleSpyMaster
+	}
+	return true
+}
+
+func (r *Room) SendChat(playerID, scope, text string) (ChatMessage, error) {
+	p, ok := r.Player(playerID)
+	if !ok {
+		return ChatMessage{}, ErrNotInRoom
+	}
+	if _, ok := ChatScopes[scope]; !ok {
+		return ChatMessage{}, ErrInvalidChatScope
+	}
+	if scope == ChatScopeSpyMasters && p.Role != PlayerRoleSpyMaster {
+		return ChatMessage{}, ErrNotSpyMaster
+	}
+
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return ChatMessage{}, ErrEmptyChat
+	}
+	if len(text) > chatMessageLength {
+		return ChatMessage{}, ErrChatTooLong
+	}
+
+	msg := ChatMessage{
+		PlayerID: p.ID,
+		NickName: p.NickName,
+		Team:     p.Team,
+		Role:     p.Role,
+		Scope:    scope,
+		Text:     text,
+		Time:     time.Now(),
+	}
+
+	r.addChat(msg)
+	return msg, nil
+}
+
+func (r *Room) addChat(msg ChatMessage) {
+	r.Chat = append(r.Chat, msg)
+	if len(r.Chat) > chatHistorySize {
+		r.Chat = r.Chat[len(r.Chat)-chatHistorySize:]
+	}
+}
+
+// announce adds a server message to the chat, the router sends it
+// to the players after the action.
+func (r *Room) announce(text string) {
+	msg := ChatMessage{
+		Scope:  ChatScopeAll,
+		Text:   text,
+		Time:   time.Now(),
+		System: true,
+	}
+	r.addChat(msg)
+	r.announcements = append(r.announcements, msg)
+}
+
+// takeAnnouncements returns the queued server messages and clears the queue
+func (r *Room) takeAnnouncements() []ChatMessage {
+	msgs := r.announcements
+	r.announcements = nil
+	return msgs
+}
+
+// ChatHistoryFor returns the messages the player is allowed to read
+func (r *Room) ChatHistoryFor(playerID string) []ChatMessage {
+	p, _ := r.Player(playerID)
+
+	history := []ChatMessage{}
+	for _, m := range r.Chat {
+		if m.VisibleTo(p) {
+			history = append(history, m)
+		}
+	}
+	return history
+}
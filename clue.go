@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ClueCountUnlimited lets guessers keep going until they miss
+const ClueCountUnlimited = -1
+
+const clueCountUnlimitedName = "unlimited"
+
+type Clue struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+}
+
+// clueJSON mirrors Clue, the ui expects "unlimited" instead of a count
+type clueJSON struct {
+	Word  string      `json:"word"`
+	Count interface{} `json:"count"`
+}
+
+func (c Clue) MarshalJSON() ([]byte, error) {
+	var count interface{} = c.Count
+	if c.Count == ClueCountUnlimited {
+		count = clueCountUnlimitedName
+	}
+	return json.Marshal(clueJSON{Word: c.Word, Count: count})
+}
+
+func (c *Clue) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Word  string          `json:"word"`
+		Count json.RawMessage `json:"count"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Word = raw.Word
+	c.Count = 0
+	if len(raw.Count) == 0 {
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw.Count, &name); err == nil {
+		count, err := ParseClueCount(name)
+		c.Count = count
+		return err
+	}
+	var n int
+	if err := json.Unmarshal(raw.Count, &n); err != nil {
+		return err
+	}
+	if err := checkClueCount(n, string(raw.Count)); err != nil {
+		return err
+	}
+	c.Count = n
+	return nil
+}
+
+// ParseClueCount reads a count sent by a client, "unlimited" is
+// accepted next to the numbers from 0 to the size of the board.
+func ParseClueCount(count string) (int, error) {
+	count = strings.TrimSpace(count)
+	if strings.EqualFold(count, clueCountUnlimitedName) {
+		return ClueCountUnlimited, nil
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return 0, ClueError{Rule: "count", Message: fmt.Sprintf("%q is not a valid count", count)}
+	}
+	if err := checkClueCount(n, count); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// checkClueCount keeps counts on the board, an unlimited clue has to
+// be asked for by name.
+func checkClueCount(n int, count string) error {
+	if n < 0 || n > boardSize {
+		return ClueError{Rule: "count", Message: fmt.Sprintf("%s is not a valid count, use 0 to %d or unlimited", count, boardSize)}
+	}
+	return nil
+}
+
+// GuessesExhausted tells if the team used up the guesses for this clue,
+// zero and unlimited clues let the team guess until they miss.
+func (c *Clue) GuessesExhausted(taken int) bool {
+	if c.Count == ClueCountUnlimited || c.Count == 0 {
+		return false
+	}
+	return taken >= c.Count+1
+}
+
+// guessesExhausted tells if the guessing team has to stop, hard games
+// don't give a bonus guess on top of the clue count.
+func (g *Game) guessesExhausted() bool {
+	if g.Clue == nil {
+		return false
+	}
+	if g.Difficulty == DifficultyHard && g.Clue.Count > 0 {
+		return g.turnsTaken >= g.Clue.Count
+	}
+	return g.Clue.GuessesExhausted(g.turnsTaken)
+}
+
+// clueLog keeps only the clues of the game log
+func clueLog(entries []GameLog) []GameLog {
+	clues := []GameLog{}
+	for _, e := range entries {
+		if e.Clue != nil {
+			clues = append(clues, e)
+		}
+	}
+	return clues
+}
+
+// ClueError is returned when a clue breaks one of the rules
+type ClueError struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e ClueError) Error() string {
+	return e.Message
+}
+
+var (
+	ErrEmptyClue   = ClueError{Rule: "empty", Message: "the clue is empty"}
+	ErrUnknownRule = ActionError{Code: "unknownRule", Message: "unknown clue rule"}
+)
+
+// ClueRule is a check a clue has to pass before it's accepted,
+// rules can be turned on and off per room. Hard rules are always
+// checked in hard games.
+type ClueRule struct {
+	Name    string
+	Default bool
+	Hard    bool
+	Check   func(g *Game, team string, c Clue) error
+}
+
+var clueRules = []ClueRule{
+	{
+		Name:    "singleWord",
+		Default: true,
+		Check: func(g *Game, team string, c Clue) error {
+			if strings.IndexFunc(c.Word, unicode.IsSpace) >= 0 {
+				return fmt.Errorf("the clue has to be a single word")
+			}
+			return nil
+		},
+	},
+	{
+		Name:    "notBoardWord",
+		Default: true,
+		Check: func(g *Game, team string, c Clue) error {
+			for _, w := range tileWords(g, false) {
+				if strings.EqualFold(w, c.Word) {
+					return fmt.Errorf("%s is on the board", c.Word)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Name:    "noSubstring",
+		Default: true,
+		Check: func(g *Game, team string, c Clue) error {
+			clue := strings.ToLower(c.Word)
+			for _, w := range tileWords(g, true) {
+				word := strings.ToLower(w)
+				if strings.Contains(word, clue) || strings.Contains(clue, word) || clueStem(word) == clueStem(clue) {
+					return fmt.Errorf("%s is too close to %s", c.Word, w)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Name:    "countInRange",
+		Default: true,
+		Check: func(g *Game, team string, c Clue) error {
+			if c.Count == ClueCountUnlimited {
+				return nil
+			}
+			left := g.tilesLeft(team)
+			if c.Count < 0 || c.Count > left {
+				return fmt.Errorf("the count has to be between 0 and %d", left)
+			}
+			return nil
+		},
+	},
+	{
+		Name:    "noZero",
+		Default: false,
+		Hard:    true,
+		Check: func(g *Game, team string, c Clue) error {
+			if c.Count == 0 {
+				return fmt.Errorf("zero clues are not allowed")
+			}
+			return nil
+		},
+	},
+	{
+		Name:    "noUnlimited",
+		Default: false,
+		Hard:    true,
+		Check: func(g *Game, team string, c Clue) error {
+			if c.Count == ClueCountUnlimited {
+				return fmt.Errorf("unlimited clues are not allowed")
+			}
+			return nil
+		},
+	},
+}
+
+func defaultClueRules() map[string]bool {
+	rules := map[string]bool{}
+	for _, rule := range clueRules {
+		rules[rule.Name] = rule.Default
+	}
+	return rules
+}
+
+func (r *Room) clueRuleEnabled(rule ClueRule) bool {
+	if rule.Hard && r.Game.Difficulty == DifficultyHard {
+		return true
+	}
+	if enabled, ok := r.ClueRules[rule.Name]; ok {
+		return enabled
+	}
+	return rule.Default
+}
+
+// validateClue runs every enabled rule and reports the first one broken
+func (r *Room) validateClue(team string, c Clue) error {
+	for _, rule := range clueRules {
+		if !r.clueRuleEnabled(rule) {
+			continue
+		}
+		if err := rule.Check(r.Game, team, c); err != nil {
+			return ClueError{Rule: rule.Name, Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+func (r *Room) SetClueRule(playerID, rule string, enabled bool) error {
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+
+	for _, cr := range clueRules {
+		if cr.Name == rule {
+			if r.ClueRules == nil {
+				r.ClueRules = defaultClueRules()
+			}
+			r.ClueRules[rule] = enabled
+			return nil
+		}
+	}
+	return ErrUnknownRule
+}
+
+// tileWords lists the words on the board, optionally only the unflipped ones
+func tileWords(g *Game, unflippedOnly bool) []string {
+	words := []string{}
+	for _, row := range g.Board {
+		for _, t := range row {
+			if len(t.Word) == 0 || (unflippedOnly && t.Flipped) {
+				continue
+			}
+			words = append(words, t.Word)
+		}
+	}
+	return words
+}
+
+// tilesLeft counts the tiles the team still has to find
+func (g *Game) tilesLeft(team string) int {
+	if g.GameType == GameTypeDuet {
+		return g.duetGreensLeft(team)
+	}
+	if team == TeamRed {
+		return g.Red
+	}
+	return g.Blue
+}
+
+// clueStem strips common english suffixes so that plurals and
+// other forms of a board word are caught.
+func clueStem(word string) string {
+	for _, suffix := range []string{"ing", "ed", "es", "er", "ly", "s"} {
+		if len(word) > len(suffix)+2 && strings.HasSuffix(word, suffix) {
+			return word[:len(word)-len(suffix)]
+		}
+	}
+	return word
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxQuorum is more guessers than a team ever has
+	maxQuorum         = 20
+	maxAutoCommitWait = 5 * 60
+)
+
+// ConsensusSettings are the numbers the quorum and auto-commit
+// policies go by
+type ConsensusSettings struct {
+	// Quorum is how many guessers have to propose a tile, teams
+	// with fewer guessers need all of them
+	Quorum int `json:"quorum"`
+	// AutoCommitSeconds is how long a proposal nobody objected to
+	// waits before the tile is flipped
+	AutoCommitSeconds int `json:"autoCommitSeconds"`
+}
+
+func defaultConsensusSettings() ConsensusSettings {
+	return ConsensusSettings{
+		Quorum:            2,
+		AutoCommitSeconds: 10,
+	}
+}
+
+// ChangeConsensusSettings sets the quorum and the auto-commit wait
+func (r *Room) ChangeConsensusSettings(playerID string, settings ConsensusSettings) error {
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+	if settings.Quorum < 1 || settings.Quorum > maxQuorum {
+		return ErrInvalidSetting
+	}
+	if settings.AutoCommitSeconds < 1 || settings.AutoCommitSeconds > maxAutoCommitWait {
+		return ErrInvalidSetting
+	}
+
+	r.ConsensusSettings = settings
+	return nil
+}
+
+// hasConsensus records the player's proposal for the tile and tells if
+// the team agreed on flipping it. Auto-commit teams never agree right
+// away, the room flips the tile once nobody objected in time.
+func (r *Room) hasConsensus(p *Player, i, j int) bool {
+	if r.Consesus == ConsensusSingle {
+		return true
+	}
+	r.propose(p, i, j)
+
+	word := r.Game.Board[i][j].Word
+	guessers := r.guessers(p.Team)
+	agreed := 0
+	for _, tp := range guessers {
+		if tp.GuessProposal != nil && *tp.GuessProposal == word {
+			agreed++
+		}
+	}
+
+	switch r.Consesus {
+	case ConsensusMajority:
+		return agreed*2 > len(guessers)
+	case ConsensusQuorum:
+		needed := r.ConsensusSettings.Quorum
+		if needed > len(guessers) {
+			needed = len(guessers)
+		}
+		return agreed >= needed
+	case ConsensusAutoCommit:
+		return false
+	}
+	return agreed == len(guessers)
+}
+
+// propose makes the tile the player's proposal, proposing the same tile
+// again keeps it as it was.
+func (r *Room) propose(p *Player, i, j int) {
+	word := r.Game.Board[i][j].Word
+	if p.GuessProposal != nil && *p.GuessProposal == word {
+		return
+	}
+
+	p.GuessProposal = &word
+	p.proposedAt = time.Now()
+	r.logEvent(p, GameLog{
+		Event: "proposeTile",
+		Team:  p.Team,
+		Tile:  &Position{I: i, J: j},
+		Word:  word,
+	})
+}
+
+// WithdrawProposal takes back the player's proposal
+func (r *Room) WithdrawProposal(playerID string) error {
+	if err := r.authorize(playerID, ActionSelectTile); err != nil {
+		return err
+	}
+
+	p := r.Players[playerID]
+	if p.GuessProposal == nil {
+		return nil
+	}
+	r.logEvent(p, GameLog{
+		Event: "withdrawProposal",
+		Team:  p.Team,
+		Word:  *p.GuessProposal,
+	})
+	p.GuessProposal = nil
+	return nil
+}
+
+// guessers are the connected players of the team who vote on guesses,
+// players who are away don't hold up their team
+func (r *Room) guessers(team string) []*Player {
+	players := []*Player{}
+	for _, p := range r.teamPlayers(team) {
+		if p.Role == PlayerRoleSpectator || p.Away {
+			continue
+		}
+		if p.Role == PlayerRoleSpyMaster && r.Game.GameType != GameTypeDuet {
+			continue
+		}
+		players = append(players, p)
+	}
+	return players
+}
+
+// pendingCommit finds the tile the guessing team agrees on and when it
+// gets flipped, a teammate proposing another tile is an objection.
+func (r *Room) pendingCommit() (*Player, time.Time, bool) {
+	if r.Consesus != ConsensusAutoCommit || r.Game.Phase != PhaseGuessing || r.Paused != nil {
+		return nil, time.Time{}, false
+	}
+
+	var last *Player
+	for _, p := range r.guessers(r.guessingTeam()) {
+		if p.GuessProposal == nil {
+			continue
+		}
+		if last != nil && *last.GuessProposal != *p.GuessProposal {
+			return nil, time.Time{}, false
+		}
+		if last == nil || p.proposedAt.After(last.proposedAt) {
+			last = p
+		}
+	}
+	if last == nil {
+		return nil, time.Time{}, false
+	}
+	wait := time.Duration(r.ConsensusSettings.AutoCommitSeconds) * time.Second
+	return last, last.proposedAt.Add(wait), true
+}
+
+// commitDue is when the room has to flip an agreed tile
+func (r *Room) commitDue() (time.Time, bool) {
+	_, at, ok := r.pendingCommit()
+	return at, ok
+}
+
+// CommitProposal flips the tile the team agreed on once its wait is
+// over, it tells if a tile was flipped. The team's proposals are
+// dropped either way so a tile that can't be flipped isn't tried again.
+func (r *Room) CommitProposal() bool {
+	p, at, ok := r.pendingCommit()
+	if !ok || time.Now().Before(at) {
+		return false
+	}
+	defer r.checkGameOver(r.Game.Over)
+
+	word := *p.GuessProposal
+	flipped := false
+	if err := r.authorize(p.ID, ActionSelectTile); err == nil {
+		for i, row := range r.Game.Board {
+			for j, tile := range row {
+				if tile.Word != word || tile.Flipped {
+					continue
+				}
+				log.WithFields(logrus.Fields{
+					"PlayerID": p.ID,
+					"RoomName": r.Name,
+					"Tile":     word,
+				}).Info("committing proposal nobody objected to")
+				flipped = r.selectTile(p, i, j, true) == nil
+			}
+		}
+	}
+
+	for _, tp := range r.teamPlayers(p.Team) {
+		tp.GuessProposal = nil
+	}
+	return flipped
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"math/rand"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	duetGreens       = 15
+	duetGreensOnSide = 9
+	duetTimerTokens  = 9
+)
+
+// DuetWinner is reported as the winner when both sides find all greens
+var DuetWinner = "everyone"
+
+// duetKeyDistribution is the layout of the double sided duet key card,
+// each entry is the tile type on the red and on the blue side.
+var duetKeyDistribution = []struct {
+	red   string
+	blue  string
+	count int
+}{
+	{TileTypeGreen, TileTypeGreen, 3},
+	{TileTypeGreen, TileTypeNeutral, 5},
+	{TileTypeGreen, TileTypeBlack, 1},
+	{TileTypeNeutral, TileTypeGreen, 5},
+	{TileTypeBlack, TileTypeGreen, 1},
+	{TileTypeBlack, TileTypeBlack, 1},
+	{TileTypeBlack, TileTypeNeutral, 1},
+	{TileTypeNeutral, TileTypeBlack, 1},
+	{TileTypeNeutral, TileTypeNeutral, 7},
+}
+
+func NewDuetGame(packs []*WordPack, weights map[string]int, difficulty string, timerAmount float64, seed int64) (*Game, error) {
+	rng := rand.New(rand.NewSource(seed))
+	turn := firstTurn(rng)
+
+	board, keyCards, err := generateDuetBoard(rng, packs, weights, difficulty)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Game{
+		GameType:    GameTypeDuet,
+		Difficulty:  difficulty,
+		TimerAmount: timerAmount,
+
+		Red:  duetGreensOnSide,
+		Blue: duetGreensOnSide,
+
+		Turn:   turn,
+		Phase:  PhaseAwaitingClue,
+		Over:   false,
+		Winner: nil,
+		Timer:  timerAmount,
+		Board:  board,
+		Log:    []GameLog{},
+		Clue:   nil,
+
+		Greens:      duetGreens,
+		TimerTokens: duetTimerTokens,
+		keyCards:    keyCards,
+		seed:        seed,
+	}
+	g.setWordPacks(packs)
+	g.record = newGameRecord(g)
+	return g, nil
+}
+
+// generateDuetBoard returns a board without tile types and the key card
+// for each team, keys are indexed row by row.
+func generateDuetBoard(rng *rand.Rand, packs []*WordPack, weights map[string]int, difficulty string) ([][]Tile, map[string][]string, error) {
+	words, err := boardWords(rng, packs, weights, difficulty)
+	if err != nil {
+		return nil, nil, err
+	}
+	red, blue := generateDuetKeys(rng)
+
+	linearTiles := make([]Tile, 25)
+	for i := range linearTiles {
+		linearTiles[i] = Tile{
+			Word:    words[i],
+			Flipped: false,
+		}
+	}
+
+	return toGrid(linearTiles), map[string][]string{
+		TeamRed:  red,
+		TeamBlue: blue,
+	}, nil
+}
+
+func generateDuetKeys(rng *rand.Rand) ([]string, []string) {
+	red := []string{}
+	blue := []string{}
+	for _, d := range duetKeyDistribution {
+		for i := 0; i < d.count; i++ {
+			red = append(red, d.red)
+			blue = append(blue, d.blue)
+		}
+	}
+
+	rng.Shuffle(len(red), func(i, j int) {
+		red[i], red[j] = red[j], red[i]
+		blue[i], blue[j] = blue[j], blue[i]
+	})
+	return red, blue
+}
+
+// duetBoardView fills in the tile types from one side of the key card,
+// flipped tiles keep the type they were revealed as.
+func duetBoardView(board [][]Tile, key []string) [][]Tile {
+	result := copyBoard(board)
+	if key == nil {
+		return result
+	}
+	for i := range result {
+		for j := range result[i] {
+			if !result[i][j].Flipped {
+				result[i][j].Type = key[i*len(result[i])+j]
+			}
+		}
+	}
+	return result
+}
+
+func (g *Game) duetKeyType(team string, i, j int) string {
+	return g.keyCards[team][i*len(g.Board[i])+j]
+}
+
+func (g *Game) duetGreensLeft(team string) int {
+	count := 0
+	for i := range g.Board {
+		for j := range g.Board[i] {
+			if !g.Board[i][j].Flipped && g.duetKeyType(team, i, j) == TileTypeGreen {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (t *Tile) hasBystander(team string) bool {
+	for _, b := range t.Bystander {
+		if b == team {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDuetTile flips a tile for a player already authorized to guess
+func (r *Room) selectDuetTile(p *Player, i, j int, committed bool) {
+	tile := &r.Game.Board[i][j]
+	if tile.Flipped || tile.hasBystander(r.Game.Turn) {
+		return
+	}
+
+	if !committed && !r.hasConsensus(p, i, j) {
+		log.WithFields(logrus.Fields{
+			"PlayerID":   p.ID,
+			"PlayerName": p.NickName,
+			"RoomName":   r.Name,
+			"Tile":       tile.Word,
+		}).Info("player tried to flip tile but they don't have consensus")
+		return
+	}
+
+	// the clue giver's side of the key decides what the guess was
+	typ := r.Game.duetKeyType(r.Game.Turn, i, j)
+	r.saveUndo()
+
+	logEntry := GameLog{
+		Event: "flipTile",
+		Tile:  &Position{I: i, J: j},
+		Word:  tile.Word,
+		Type:  typ,
+		Team:  p.Team,
+	}
+
+	switch typ {
+	case TileTypeBlack:
+		tile.Flipped = true
+		tile.Type = typ
+		r.Game.end(nil)
+		logEntry.EndedTurn = true
+
+	case TileTypeNeutral:
+		tile.Bystander = append(tile.Bystander, r.Game.Turn)
+		logEntry.EndedTurn = true
+
+	case TileTypeGreen:
+		tile.Flipped = true
+		tile.Type = typ
+		r.Game.Greens--
+		r.Game.Red = r.Game.duetGreensLeft(TeamRed)
+		r.Game.Blue = r.Game.duetGreensLeft(TeamBlue)
+	}
+
+	r.clearGuessProposals()
+	r.logEvent(p, logEntry)
+
+	if r.Game.Greens == 0 {
+		r.Game.end(&DuetWinner)
+		return
+	}
+
+	if logEntry.EndedTurn && !r.Game.Over {
+		r.switchTurns()
+	}
+}
+
+// spendTimerToken uses up one turn of the shared budget,
+// the game is lost once no turns are left.
+func (r *Room) spendTimerToken() {
+	if r.Game.TimerTokens > 0 {
+		r.Game.TimerTokens--
+	}
+	if r.Game.TimerTokens == 0 && !r.Game.Over {
+		log.WithField("RoomName", r.Name).Info("duet game ran out of turns")
+		r.Game.end(nil)
+	}
+}
+
+// nextDuetTurn picks the team giving the next clue, a side without
+// greens left on its key has nothing to give clues for.
+func (r *Room) nextDuetTurn() string {
+	next := otherTeam(r.Game.Turn)
+	if r.Game.duetGreensLeft(next) == 0 {
+		return r.Game.Turn
+	}
+	return next
+}
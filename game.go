@@ -5,32 +5,39 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"strings"
+	"time"
 
 	"github.com/markbates/pkger"
 )
 
-var (
-	DefaultWords       = []string{}
-	NsfwWords          = []string{}
-	DuetWords          = []string{}
-	UndercoverWords    = []string{}
-	CustomWords        = []string{}
-	BoardTypeToWordSet = map[BoardType]*[]string{}
+const (
+	boardSize = 25
+
+	// hardWordLength is the shortest word used on hard boards
+	hardWordLength = 6
 )
 
+// builtinPacks are embedded in the binary, more packs can be
+// loaded from a directory at startup.
+var builtinPacks = []struct {
+	id, name, file string
+}{
+	{"base", "Base", "/server/words.txt"},
+	{"duet", "Duet", "/server/duet-words.txt"},
+	{"undercover", "Undercover", "/server/undercover-words.txt"},
+	{"custom", "Custom", "/server/custom-words.txt"},
+	{"nsfw", "NSFW", "/server/nsfw-words.txt"},
+}
+
 func init() {
-	DefaultWords = readWords("/server/words.txt")
-	NsfwWords = readWords("/server/nsfw-words.txt")
-	DuetWords = readWords("/server/duet-words.txt")
-	UndercoverWords = readWords("/server/duet-words.txt")
-	CustomWords = readWords("/server/custom-words.txt")
-
-	BoardTypeToWordSet = map[BoardType]*[]string{
-		BoardTypeDefault:    &DefaultWords,
-		BoardTypeDuet:       &DuetWords,
-		BoardTypeCustom:     &CustomWords,
-		BoardTypeNsfw:       &NsfwWords,
-		BoardTypeUndercover: &UndercoverWords,
+	for _, b := range builtinPacks {
+		p, err := NewWordPack(b.id, b.name, readWords(b.file))
+		if err != nil {
+			panic(fmt.Sprintf("invalid built in word pack %s: %s", b.id, err))
+		}
+		if err := wordPacks.Register(p); err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -49,17 +56,25 @@ func readWords(file string) []string {
 }
 
 type Player struct {
-	ID            string  `json:"id"`
-	NameAvailable bool    `json:"nameAvailable"`
-	TempName      string  `json:"tempName"`
-	Counter       int     `json:"counter"`
-	NickName      string  `json:"nickname"`
-	Room          string  `json:"room"`
-	Team          string  `json:"team"`
-	Role          string  `json:"role"`
-	GuessProposal *string `json:"guessProposal"`
-	Timeout       int     `json:"timeout"`
-	AfkTimer      int     `json:"afkTimer"`
+	ID            string    `json:"id"`
+	NameAvailable bool      `json:"nameAvailable"`
+	TempName      string    `json:"tempName"`
+	Counter       int       `json:"counter"`
+	NickName      string    `json:"nickname"`
+	Room          string    `json:"room"`
+	Team          string    `json:"team"`
+	Role          string    `json:"role"`
+	GuessProposal *string   `json:"guessProposal"`
+	Timeout       int       `json:"timeout"`
+	AfkTimer      int       `json:"afkTimer"`
+	Away          bool      `json:"away"`
+	Ready         bool      `json:"ready"`
+	JoinedAt      time.Time `json:"joinedAt"`
+
+	proposedAt time.Time
+
+	// the host the player connects from, kept out of the game state
+	addr string
 }
 
 var (
@@ -67,44 +82,61 @@ var (
 	TileTypeRed     = "red"
 	TileTypeBlack   = "death"
 	TileTypeNeutral = "neutral"
+	TileTypeGreen   = "green"
 )
 
 type Tile struct {
 	Word    string `json:"word"`
 	Flipped bool   `json:"flipped"`
 	Type    string `json:"type"`
+
+	// duet only, teams that found a bystander on their side of the key
+	Bystander []string `json:"bystander,omitempty"`
 }
 
 var (
 	TeamBlue = "blue"
 	TeamRed  = "red"
+	Teams    = buildSet(TeamBlue, TeamRed)
 )
 
-type Clue struct {
-	Word  string `json:"word"`
-	Count int    `json:"count"`
+// GameLog is one event of a game, the log of a game together with
+// its starting board is enough to replay it.
+type GameLog struct {
+	Event     string    `json:"event,omitempty"`
+	Time      time.Time `json:"time"`
+	Player    string    `json:"player,omitempty"`
+	Team      string    `json:"team,omitempty"`
+	Tile      *Position `json:"tile,omitempty"`
+	Word      string    `json:"word,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Clue      *Clue     `json:"clue,omitempty"`
+	EndedTurn bool      `json:"endedTurn"`
+
+	// Undone marks moves that were taken back
+	Undone bool `json:"undone,omitempty"`
 }
 
-type GameLog struct {
-	Event     string `json:"event,omitempty"`
-	Team      string `json:"team,omitempty"`
-	Word      string `json:"word,omitempty"`
-	Type      string `json:"type,omitempty"`
-	Clue      *Clue  `json:"clue,omitempty"`
-	EndedTurn bool   `json:"endedTurn"`
+// Position is a tile on the board
+type Position struct {
+	I int `json:"i"`
+	J int `json:"j"`
 }
 
 type Game struct {
+	GameType    string  `json:"gameType"`
+	Difficulty  string  `json:"difficulty"`
 	TimerAmount float64 `json:"timerAmount"`
 	WordPool    int     `json:"wordPool"`
 
-	// Game types, these are kept here for the UI
-	// actual board types are stored in the room
-	Base       bool `json:"base"`
-	Duet       bool `json:"duet"`
-	Undercover bool `json:"undercover"`
-	Custom     bool `json:"custom"`
-	Nsfw       bool `json:"nsfw"`
+	// Word packs, the flags of the built in packs are kept
+	// for the UI, the selection is stored in the room
+	WordPacks  []string `json:"wordPacks"`
+	Base       bool     `json:"base"`
+	Duet       bool     `json:"duet"`
+	Undercover bool     `json:"undercover"`
+	Custom     bool     `json:"custom"`
+	Nsfw       bool     `json:"nsfw"`
 
 	// player count
 	Red  int `json:"red"`
@@ -112,6 +144,7 @@ type Game struct {
 
 	// game state
 	Turn   string    `json:"turn"`
+	Phase  string    `json:"phase"`
 	Over   bool      `json:"over"`
 	Winner *string   `json:"winner"`
 	Timer  float64   `json:"timer"`
@@ -119,66 +152,167 @@ type Game struct {
 	Log    []GameLog `json:"log"`
 	Clue   *Clue     `json:"clue"`
 
+	// separate seconds for giving the clue and for guessing, 0 uses
+	// TimerAmount for the whole turn. Bank is the time each team has
+	// left for the whole game when playing with a chess clock.
+	ClueTime  float64            `json:"clueTime,omitempty"`
+	GuessTime float64            `json:"guessTime,omitempty"`
+	Bank      map[string]float64 `json:"bank,omitempty"`
+
+	// duet state, greens left across both sides of the key
+	// and the shared turns budget. Both are always sent so 0 left
+	// shows, classic games have 0 of each.
+	Greens      int `json:"greens"`
+	TimerTokens int `json:"timerTokens"`
+
 	turnsTaken int
+	keyCards   map[string][]string
+
+	// seed deals the board, the same seed, packs and difficulty
+	// always give the same board
+	seed int64
+
+	// record has the board as it was dealt for the game's history
+	record *GameRecord
 }
 
-func NewGame(bt BoardType, timerAmount float64) *Game {
+func NewGame(packs []*WordPack, weights map[string]int, difficulty string, timerAmount float64, seed int64) (*Game, error) {
+	rng := rand.New(rand.NewSource(seed))
 	blueTiles := 9
 	redTiles := 8
 
-	turn := TeamBlue
-	if rand.Intn(100)%2 == 0 {
-		turn = TeamRed
+	turn := firstTurn(rng)
+	if turn == TeamRed {
 		blueTiles = 8
 		redTiles = 9
 	}
 
-	return &Game{
-		TimerAmount: timerAmount,
-		WordPool:    wordpoolSize(bt),
+	board, err := generateBoard(rng, packs, weights, difficulty, turn)
+	if err != nil {
+		return nil, err
+	}
 
-		Base:       isSet(bt, BoardTypeDefault),
-		Duet:       isSet(bt, BoardTypeDuet),
-		Undercover: isSet(bt, BoardTypeUndercover),
-		Custom:     isSet(bt, BoardTypeCustom),
-		Nsfw:       isSet(bt, BoardTypeNsfw),
+	g := &Game{
+		GameType:    GameTypeClassic,
+		Difficulty:  difficulty,
+		TimerAmount: timerAmount,
+		WordPool:    wordpoolSize(packs),
 
 		Red:  redTiles,
 		Blue: blueTiles,
 
 		Turn:   turn,
+		Phase:  PhaseAwaitingClue,
 		Over:   false,
 		Winner: nil,
 		Timer:  timerAmount,
-		Board:  generateBoard(bt, turn),
+		Board:  board,
 		Log:    []GameLog{},
 		Clue:   nil,
+
+		seed: seed,
 	}
+	g.setWordPacks(packs)
+	g.record = newGameRecord(g)
+	return g, nil
 }
 
-func wordpoolSize(bt BoardType) int {
+// firstTurn picks the team that starts, it's the first thing dealt
+// from a game's seed so the seed alone tells who starts
+func firstTurn(rng *rand.Rand) string {
+	if rng.Intn(100)%2 == 0 {
+		return TeamRed
+	}
+	return TeamBlue
+}
+
+// setWordPacks records the packs the board was dealt from
+func (g *Game) setWordPacks(packs []*WordPack) {
+	g.WordPacks = packIDs(packs)
+	g.WordPool = wordpoolSize(packs)
+
+	g.Base = hasPack(g.WordPacks, "base")
+	g.Duet = hasPack(g.WordPacks, "duet")
+	g.Undercover = hasPack(g.WordPacks, "undercover")
+	g.Custom = hasPack(g.WordPacks, "custom")
+	g.Nsfw = hasPack(g.WordPacks, "nsfw")
+}
+
+func wordpoolSize(packs []*WordPack) int {
 	count := 0
-	visitBoardType(bt, func(bt BoardType) {
-		count += len(*BoardTypeToWordSet[bt])
-	})
+	for _, p := range packs {
+		count += len(p.Words)
+	}
 	return count
 }
 
-func generateBoard(bt BoardType, turn string) [][]Tile {
-	totalWords := 25
-	setsEnabled := getTotalSetsEnabled(bt)
+func generateBoard(rng *rand.Rand, packs []*WordPack, weights map[string]int, difficulty, turn string) ([][]Tile, error) {
+	words, err := boardWords(rng, packs, weights, difficulty)
+	if err != nil {
+		return nil, err
+	}
+	linearTiles := generateLinearTiles(words, turn)
+	if difficulty == DifficultyHard {
+		linearTiles = generateHardLinearTiles(words, turn)
+	}
+
+	rng.Shuffle(len(linearTiles), func(i, j int) { linearTiles[i], linearTiles[j] = linearTiles[j], linearTiles[i] })
 
-	if setsEnabled == 0 {
-		setsEnabled = 1
-		bt = BoardTypeDefault
+	return toGrid(linearTiles), nil
+}
+
+// boardWords deals the words for a board, each pack gives its share
+// of the words and no word is used twice.
+func boardWords(rng *rand.Rand, packs []*WordPack, weights map[string]int, difficulty string) ([]string, error) {
+	shares, err := packShares(packs, weights, boardSize)
+	if err != nil {
+		return nil, err
 	}
 
-	wordsPerSet := (totalWords / setsEnabled) + 1
-	words := getWords(bt, wordsPerSet)
-	linearTiles := generateLinearTiles(words, turn)
+	taken := map[string]struct{}{}
+	words := []string{}
+	for i, p := range packs {
+		set := p.Words
+		if difficulty == DifficultyHard {
+			set = hardWords(set, shares[i], taken)
+		}
 
-	rand.Shuffle(len(linearTiles), func(i, j int) { linearTiles[i], linearTiles[j] = linearTiles[j], linearTiles[i] })
+		picked, err := selectWords(rng, set, shares[i], taken)
+		if err != nil {
+			return nil, ActionError{
+				Code:    ErrWordsRanOut.Code,
+				Message: fmt.Sprintf("%s doesn't have %d more words for the board", p.Name, shares[i]),
+			}
+		}
+		words = append(words, picked...)
+	}
 
+	// the tile types are handed out in order, so packs must not line up with colors
+	rng.Shuffle(len(words), func(i, j int) { words[i], words[j] = words[j], words[i] })
+	return words, nil
+}
+
+// selectWords picks count words that are not taken yet and marks them taken
+func selectWords(rng *rand.Rand, arr []string, count int, lookupTable map[string]struct{}) ([]string, error) {
+	picked := []string{}
+	for _, i := range rng.Perm(len(arr)) {
+		if len(picked) == count {
+			break
+		}
+		if _, ok := lookupTable[arr[i]]; ok {
+			continue
+		}
+		lookupTable[arr[i]] = struct{}{}
+		picked = append(picked, arr[i])
+	}
+
+	if len(picked) < count {
+		return nil, ErrWordsRanOut
+	}
+	return picked, nil
+}
+
+func toGrid(linearTiles []Tile) [][]Tile {
 	result := [][]Tile{}
 
 	width := 5
@@ -190,61 +324,49 @@ func generateBoard(bt BoardType, turn string) [][]Tile {
 	return result
 }
 
-func getTotalSetsEnabled(bt BoardType) int {
-	setsEnabled := 0
-	visitBoardType(bt, func(bt BoardType) {
-		setsEnabled++
-	})
-	return setsEnabled
-}
-
-func visitBoardType(bt BoardType, visitor func(bt BoardType)) {
-	if isSet(bt, BoardTypeDefault) {
-		visitor(BoardTypeDefault)
-	}
-	if isSet(bt, BoardTypeCustom) {
-		visitor(BoardTypeCustom)
-	}
-	if isSet(bt, BoardTypeDuet) {
-		visitor(BoardTypeDuet)
-	}
-	if isSet(bt, BoardTypeNsfw) {
-		visitor(BoardTypeNsfw)
-	}
-	if isSet(bt, BoardTypeUndercover) {
-		visitor(BoardTypeUndercover)
+func copyBoard(board [][]Tile) [][]Tile {
+	result := make([][]Tile, len(board))
+	for i := range board {
+		result[i] = make([]Tile, len(board[i]))
+		copy(result[i], board[i])
 	}
+	return result
 }
 
-func getWords(bt BoardType, wordsPerSet int) []string {
-	words := map[string]struct{}{}
-
-	visitBoardType(bt, func(bt BoardType) {
-		selectWords(*BoardTypeToWordSet[bt], wordsPerSet, words)
-	})
-
-	result := []string{}
-	for k := range words {
-		result = append(result, k)
+// hiddenBoardView removes the types of tiles that are not flipped yet
+func hiddenBoardView(board [][]Tile) [][]Tile {
+	result := copyBoard(board)
+	for i := range result {
+		for j := range result[i] {
+			if !result[i][j].Flipped {
+				result[i][j].Type = ""
+			}
+		}
 	}
 	return result
 }
 
-func isSet(bt, typ BoardType) bool {
-	return (bt & typ) != 0
-}
-
-func selectWords(arr []string, count int, lookupTable map[string]struct{}) {
-	for i := 0; i < count; {
-		di := rand.Intn(len(arr))
-		if _, ok := lookupTable[arr[di]]; ok {
+// hardWords keeps the longer words of a set, they tend to be less
+// concrete and harder to link. Words already taken by other packs are
+// left out, short sets fall back to every word that is left.
+func hardWords(set []string, count int, taken map[string]struct{}) []string {
+	result := []string{}
+	left := []string{}
+	for _, w := range set {
+		if _, ok := taken[w]; ok {
 			continue
 		}
-		lookupTable[arr[di]] = struct{}{}
-		i++
+		left = append(left, w)
+		if len(w) >= hardWordLength {
+			result = append(result, w)
+		}
 	}
-
+	if len(result) < count {
+		return left
+	}
+	return result
 }
+
 func generateLinearTiles(words []string, turn string) []Tile {
 	linearTiles := make([]Tile, 25)
 
@@ -296,3 +418,36 @@ func generateLinearTiles(words []string, turn string) []Tile {
 	}
 	return linearTiles
 }
+
+// generateHardLinearTiles lays out a hard board, there are three
+// assassins and only five neutral tiles to fall back on.
+func generateHardLinearTiles(words []string, turn string) []Tile {
+	firstColor := TileTypeBlue
+	secondColor := TileTypeRed
+	if turn == TeamRed {
+		firstColor = TileTypeRed
+		secondColor = TileTypeBlue
+	}
+
+	layout := []struct {
+		typ   string
+		count int
+	}{
+		{TileTypeBlack, 3},
+		{firstColor, 9},
+		{secondColor, 8},
+		{TileTypeNeutral, 5},
+	}
+
+	linearTiles := []Tile{}
+	for _, l := range layout {
+		for i := 0; i < l.count; i++ {
+			linearTiles = append(linearTiles, Tile{
+				Word:    words[len(linearTiles)],
+				Type:    l.typ,
+				Flipped: false,
+			})
+		}
+	}
+	return linearTiles
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"time"
+)
+
+const (
+	gameRecordVersion = 1
+
+	// maxHistory is how many past games a room keeps
+	maxHistory = 20
+)
+
+var ErrNoGameRecord = ActionError{Code: "noGameRecord", Message: "there is no finished game to export yet"}
+
+// replayOnlyEvents are kept in the log for replays but not shown in
+// the game's log
+var replayOnlyEvents = buildSet("proposeTile", "withdrawProposal", "undo")
+
+// GameRecord is a complete game, the board as it was dealt and every
+// event after that. It's what games are exported and replayed from.
+type GameRecord struct {
+	Version     int                 `json:"version"`
+	GameType    string              `json:"gameType"`
+	Difficulty  string              `json:"difficulty"`
+	BoardCode   string              `json:"boardCode"`
+	TimerAmount float64             `json:"timerAmount"`
+	StartedAt   time.Time           `json:"startedAt"`
+	Turn        string              `json:"turn"`
+	Board       [][]Tile            `json:"board"`
+	KeyCards    map[string][]string `json:"keyCards,omitempty"`
+	Log         []GameLog           `json:"log"`
+	Over        bool                `json:"over"`
+	Winner      *string             `json:"winner"`
+}
+
+// newGameRecord keeps the state of a game that was just dealt
+func newGameRecord(g *Game) *GameRecord {
+	return &GameRecord{
+		Version:     gameRecordVersion,
+		GameType:    g.GameType,
+		Difficulty:  g.Difficulty,
+		BoardCode:   boardCode(g.seed),
+		TimerAmount: g.TimerAmount,
+		StartedAt:   time.Now(),
+		Turn:        g.Turn,
+		Board:       copyBoard(g.Board),
+		KeyCards:    g.keyCards,
+		Log:         []GameLog{},
+	}
+}
+
+// Record returns the game's record with the events so far
+func (g *Game) Record() *GameRecord {
+	rec := newGameRecord(g)
+	if g.record != nil {
+		*rec = *g.record
+	}
+	rec.Log = append([]GameLog{}, g.Log...)
+	rec.Over = g.Over
+	rec.Winner = g.Winner
+	return rec
+}
+
+// logEvent stamps the event with the time and the player who caused
+// it and adds it to the game's log, p is nil for the server.
+func (r *Room) logEvent(p *Player, entry GameLog) {
+	entry.Time = time.Now()
+	if p != nil {
+		entry.Player = p.NickName
+	}
+	r.Game.Log = append(r.Game.Log, entry)
+}
+
+// archiveGame moves the current game to the history before it's
+// replaced, games nobody played aren't kept.
+func (r *Room) archiveGame() {
+	if r.Game == nil || len(r.Game.Log) == 0 || r.Replay != nil {
+		return
+	}
+	r.History = append(r.History, r.Game.Record())
+	if len(r.History) > maxHistory {
+		r.History = r.History[len(r.History)-maxHistory:]
+	}
+}
+
+// ExportGame returns the record of the current game once it's over,
+// or else of the last game played. Running games can't be exported
+// since the record has the whole key.
+func (r *Room) ExportGame(playerID string) (*GameRecord, error) {
+	if _, ok := r.Player(playerID); !ok {
+		return nil, ErrNotInRoom
+	}
+
+	switch {
+	case r.Replay != nil:
+		return r.Replay.Record, nil
+	case r.Game.Over:
+		return r.Game.Record(), nil
+	case len(r.History) > 0:
+		return r.History[len(r.History)-1], nil
+	}
+	return nil, ErrNoGameRecord
+}
+
+// playLog drops the events that are only kept for replays and the
+// moves that were taken back
+func playLog(entries []GameLog) []GameLog {
+	result := []GameLog{}
+	for _, e := range entries {
+		if _, ok := replayOnlyEvents[e.Event]; !ok && !e.Undone {
+			result = append(result, e)
+		}
+	}
+	return result
+}
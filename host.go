@@ -0,0 +1,136 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrPlayerNotFound = ActionError{Code: "playerNotFound", Message: "player is not in the room"}
+	ErrKickSelf       = ActionError{Code: "kickSelf", Message: "you can't kick yourself"}
+)
+
+func (r *Room) IsHost(playerID string) bool {
+	return r.Host != "" && r.Host == playerID
+}
+
+// IsBanned tells if the player or anyone connecting from addr was banned,
+// a new session doesn't get a banned player back in.
+func (r *Room) IsBanned(playerID, addr string) bool {
+	if _, ok := r.Banned[playerID]; ok {
+		return true
+	}
+	_, ok := r.BannedAddrs[addr]
+	return ok && addr != ""
+}
+
+// Kick checks that the host may remove the target and records the ban,
+// actually removing the player is left to the router.
+func (r *Room) Kick(hostID, targetID string, ban bool) error {
+	if err := r.authorize(hostID, ActionModerate); err != nil {
+		return err
+	}
+	if hostID == targetID {
+		return ErrKickSelf
+	}
+
+	target, inRoom := r.Player(targetID)
+	if !inRoom && !ban {
+		return ErrPlayerNotFound
+	}
+
+	if ban {
+		if r.Banned == nil {
+			r.Banned = map[string]struct{}{}
+		}
+		r.Banned[targetID] = struct{}{}
+		if inRoom && target.addr != "" {
+			if r.BannedAddrs == nil {
+				r.BannedAddrs = map[string]struct{}{}
+			}
+			r.BannedAddrs[target.addr] = struct{}{}
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"HostID":   hostID,
+		"PlayerID": targetID,
+		"RoomName": r.Name,
+		"Ban":      ban,
+	}).Info("host removed player")
+	return nil
+}
+
+func (r *Room) TransferHost(hostID, targetID string) error {
+	if err := r.authorize(hostID, ActionModerate); err != nil {
+		return err
+	}
+	if _, ok := r.Player(targetID); !ok {
+		return ErrPlayerNotFound
+	}
+	r.Host = targetID
+	return nil
+}
+
+// AssignTeam lets the host move a player to a team, also while a game
+// is being played
+func (r *Room) AssignTeam(hostID, targetID, team string) error {
+	if _, ok := Teams[team]; !ok {
+		return ErrInvalidSetting
+	}
+	if err := r.authorize(hostID, ActionAssignRoles); err != nil {
+		return err
+	}
+	p, ok := r.Player(targetID)
+	if !ok {
+		return ErrPlayerNotFound
+	}
+	r.setTeam(p, team)
+	return nil
+}
+
+// AssignRole lets the host give a player a role, like a new spymaster
+// for a team whose spymaster left
+func (r *Room) AssignRole(hostID, targetID, role string) error {
+	if _, ok := PlayerRoles[role]; !ok {
+		return ErrInvalidSetting
+	}
+	if err := r.authorize(hostID, ActionAssignRoles); err != nil {
+		return err
+	}
+	p, ok := r.Player(targetID)
+	if !ok {
+		return ErrPlayerNotFound
+	}
+	r.setRole(p, role)
+	return nil
+}
+
+func (r *Room) LockSettings(hostID string, locked bool) error {
+	if err := r.authorize(hostID, ActionModerate); err != nil {
+		return err
+	}
+	r.SettingsLocked = locked
+	return nil
+}
+
+// pickNewHost hands the room to the longest present player,
+// players who are connected are preferred over away ones.
+func (r *Room) pickNewHost() {
+	var next *Player
+	for _, p := range r.Players {
+		if next == nil ||
+			(next.Away && !p.Away) ||
+			(next.Away == p.Away && p.JoinedAt.Before(next.JoinedAt)) {
+			next = p
+		}
+	}
+
+	r.Host = ""
+	if next != nil {
+		r.Host = next.ID
+		log.WithFields(logrus.Fields{
+			"PlayerID": next.ID,
+			"RoomName": r.Name,
+		}).Info("handing over room to new host")
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrInLobby     = ActionError{Code: "inLobby", Message: "the game hasn't started yet"}
+	ErrGameStarted = ActionError{Code: "gameStarted", Message: "the game has already started"}
+)
+
+// SetReady marks the player as ready to play, the game starts once
+// everyone is ready and both teams can play.
+func (r *Room) SetReady(playerID string, ready bool) error {
+	if err := r.authorize(playerID, ActionReady); err != nil {
+		return err
+	}
+	if r.gameStarted() {
+		return ErrGameStarted
+	}
+
+	r.Players[playerID].Ready = ready
+	log.WithFields(logrus.Fields{
+		"PlayerID": playerID,
+		"RoomName": r.Name,
+		"Ready":    ready,
+	}).Info("player changed ready")
+
+	r.startIfReady()
+	return nil
+}
+
+// ForceStart lets the host start the game without waiting for everyone
+func (r *Room) ForceStart(playerID string) error {
+	if err := r.authorize(playerID, ActionModerate); err != nil {
+		return err
+	}
+	if r.gameStarted() {
+		return ErrGameStarted
+	}
+
+	r.leaveLobby()
+	return nil
+}
+
+// startIfReady starts the game when every connected player is ready
+// and each team has someone to give clues and someone to guess
+func (r *Room) startIfReady() {
+	if r.Game == nil || r.gameStarted() {
+		return
+	}
+
+	spymasters := map[string]int{}
+	guessers := map[string]int{}
+	for _, p := range r.Players {
+		if p.Role == PlayerRoleSpectator || p.Away {
+			continue
+		}
+		if !p.Ready {
+			return
+		}
+		// duet players take both roles
+		if p.Role == PlayerRoleSpyMaster || r.Game.GameType == GameTypeDuet {
+			spymasters[p.Team]++
+		}
+		if p.Role == PlayerRoleGuesser || r.Game.GameType == GameTypeDuet {
+			guessers[p.Team]++
+		}
+	}
+	for _, team := range []string{TeamRed, TeamBlue} {
+		if spymasters[team] == 0 || guessers[team] == 0 {
+			return
+		}
+	}
+	r.leaveLobby()
+}
+
+// leaveLobby starts the clock and the first turn
+func (r *Room) leaveLobby() {
+	r.Game.setPhase(PhaseAwaitingClue)
+	r.Game.Timer = r.Game.phaseTime()
+	for _, p := range r.Players {
+		p.Ready = false
+	}
+	r.announce("The game started")
+
+	log.WithField("RoomName", r.Name).Info("game started")
+}
+
+// unready takes back the player's ready when they change their team
+// or role in the lobby, the others agreed to the old teams
+func (r *Room) unready(p *Player) {
+	if r.Game != nil && r.Game.Phase == PhaseLobby {
+		p.Ready = false
+	}
+}
+
+// gameStarted is false while the players are still in the lobby
+func (r *Room) gameStarted() bool {
+	return r.Game.Phase != PhaseLobby
+}
@@ -3,16 +3,26 @@ package main
 import (
 	"flag"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/markbates/pkger"
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	listenAll = flag.Bool("all", false, "listen to any address or just localhost. localhost by default")
-	portFlag  = flag.Int("port", 8080, "server port")
+	listenAll      = flag.Bool("all", false, "listen to any address or just localhost. localhost by default")
+	portFlag       = flag.Int("port", 8080, "server port")
+	reconnectGrace = flag.Duration("reconnect-grace", 10*time.Minute, "how long a disconnected player keeps their spot in a room")
+	roomGrace      = flag.Duration("room-grace", 10*time.Hour, "how long an empty room is kept before it's closed")
+	afkTimeout     = flag.Duration("afk-timeout", 3*time.Hour, "how long a player can be inactive before they are kicked, 0 disables it")
+	dataDir        = flag.String("data", "", "directory to save rooms in so they survive restarts. rooms are only kept in memory by default")
+	saveDelay      = flag.Duration("save-delay", defaultSaveDelay, "how long a changed room waits before it's saved to the data directory")
+	packsDir       = flag.String("packs", "", "directory with extra word packs, one .txt file per pack with a word on each line")
+	statsFile      = flag.String("stats", "", "file to keep player stats in, stats are only kept in memory by default")
+	apiToken       = flag.String("api-token", "", "bearer token for the /api/rooms admin api, the api is disabled without one")
 )
 
 var log = logrus.New()
@@ -20,10 +30,45 @@ var log = logrus.New()
 func main() {
 	flag.Parse()
 	log.Out = os.Stdout
+	// ids and the seeds of new games come from here, boards are dealt
+	// from their own generator
+	rand.Seed(time.Now().UnixNano())
 	pkger.Include("/server")
 	pkger.Include("/public")
 
-	server := socketServer(NewActionRouter())
+	if *packsDir != "" {
+		if err := wordPacks.LoadDir(*packsDir); err != nil {
+			log.Fatalf("unable to load word packs: %s\n", err)
+		}
+	}
+
+	var store RoomStore
+	if *dataDir != "" {
+		fs, err := NewFileRoomStore(*dataDir)
+		if err != nil {
+			log.Fatalf("unable to open room store: %s\n", err)
+		}
+		store = fs
+	}
+
+	stats := NewStats(nopStatsStore{})
+	if *statsFile != "" {
+		fs, err := NewFileStatsStore(*statsFile)
+		if err != nil {
+			log.Fatalf("unable to open stats file: %s\n", err)
+		}
+		stats = NewStats(fs)
+	}
+
+	router := NewActionRouter(RouterConfig{
+		Store:          store,
+		Stats:          stats,
+		ReconnectGrace: *reconnectGrace,
+		EmptyRoomGrace: *roomGrace,
+		AfkTimeout:     *afkTimeout,
+		SaveDelay:      *saveDelay,
+	})
+	server := socketServer(router)
 	go func() {
 		if err := server.Serve(); err != nil {
 			log.Fatalf("socketio listen error: %s\n", err)
@@ -32,6 +77,11 @@ func main() {
 	defer server.Close()
 
 	http.Handle("/socket.io/", server)
+	http.Handle("/ws", newWsServer(router))
+	api := newAPIServer(router, *apiToken)
+	http.Handle(apiRoomsPath, api)
+	http.Handle(apiRoomsPath+"/", api)
+	http.Handle(apiStatsPath, api)
 	http.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
 		// original API pinged, keep it?
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrGamePaused = ActionError{Code: "gamePaused", Message: "the game is paused"}
+	ErrNotPaused  = ActionError{Code: "notPaused", Message: "the game isn't paused"}
+)
+
+// Pause is who froze the game and when, the host alone or a player
+// of each team
+type Pause struct {
+	By []string  `json:"by"`
+	At time.Time `json:"at"`
+}
+
+// PauseGame stops the clock and the moves of the game, right away when
+// the host asks and otherwise once a player of each team asked for it.
+func (r *Room) PauseGame(playerID string) error {
+	if err := r.authorize(playerID, ActionPause); err != nil {
+		return err
+	}
+	if r.Paused != nil {
+		return ErrGamePaused
+	}
+
+	by, agreed := r.pauseAgreed(playerID)
+	if !agreed {
+		return nil
+	}
+	r.Paused = &Pause{
+		By: by,
+		At: time.Now(),
+	}
+	r.announce(fmt.Sprintf("%s paused the game", strings.Join(by, " and ")))
+
+	log.WithFields(logrus.Fields{
+		"PlayerID": playerID,
+		"RoomName": r.Name,
+	}).Info("paused the game")
+	return nil
+}
+
+// ResumeGame lets the game go on, it needs the same agreement as pausing
+func (r *Room) ResumeGame(playerID string) error {
+	if err := r.authorize(playerID, ActionPause); err != nil {
+		return err
+	}
+	if r.Paused == nil {
+		return ErrNotPaused
+	}
+
+	by, agreed := r.pauseAgreed(playerID)
+	if !agreed {
+		return nil
+	}
+	r.Paused = nil
+	r.announce(fmt.Sprintf("%s resumed the game", strings.Join(by, " and ")))
+
+	log.WithFields(logrus.Fields{
+		"PlayerID": playerID,
+		"RoomName": r.Name,
+	}).Info("resumed the game")
+	return nil
+}
+
+// pauseAgreed counts the player's vote for pausing or resuming, it
+// returns who agreed once the host voted or both teams did.
+func (r *Room) pauseAgreed(playerID string) ([]string, bool) {
+	p := r.Players[playerID]
+	if r.IsHost(playerID) {
+		r.pauseVotes = nil
+		return []string{p.NickName}, true
+	}
+
+	if r.pauseVotes == nil {
+		r.pauseVotes = map[string]struct{}{}
+	}
+	r.pauseVotes[playerID] = struct{}{}
+
+	teams := map[string]bool{}
+	for id := range r.pauseVotes {
+		if v, ok := r.Player(id); ok {
+			teams[v.Team] = true
+		}
+	}
+	if !teams[TeamRed] || !teams[TeamBlue] {
+		return nil, false
+	}
+
+	by := r.pauseVoters()
+	r.pauseVotes = nil
+	return by, true
+}
+
+// pauseVoters are the nicknames of the players waiting for the other
+// team to agree
+func (r *Room) pauseVoters() []string {
+	names := []string{}
+	for id := range r.pauseVotes {
+		if p, ok := r.Player(id); ok {
+			names = append(names, p.NickName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// clearPause lets a new game start unpaused
+func (r *Room) clearPause() {
+	r.Paused = nil
+	r.pauseVotes = nil
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Action names a request a player can make, each one is checked
+// against the permission table before the room acts on it.
+type Action string
+
+const (
+	ActionNewGame        Action = "newGame"
+	ActionRandomizeTeams Action = "randomizeTeams"
+	ActionChangeTeam     Action = "joinTeam"
+	ActionSwitchRole     Action = "switchRole"
+	ActionSpectate       Action = "spectate"
+	ActionTakeSpymaster  Action = "takeSpymaster"
+	ActionAssignRoles    Action = "assignRoles"
+	ActionChangeCards    Action = "changeCards"
+	ActionSwitchGameType Action = "switchGameType"
+	ActionChangeSettings Action = "changeSettings"
+	ActionEndTurn        Action = "endTurn"
+	ActionSelectTile     Action = "clickTile"
+	ActionDeclareClue    Action = "declareClue"
+	ActionModerate       Action = "moderate"
+	ActionReplay         Action = "replay"
+	ActionUndo           Action = "undo"
+	ActionPause          Action = "pause"
+	ActionReady          Action = "ready"
+)
+
+// ActionError tells a player why they weren't allowed to do something
+type ActionError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e ActionError) Error() string {
+	return e.Message
+}
+
+var (
+	ErrNotInRoom      = ActionError{Code: "notInRoom", Message: "player is not in the room"}
+	ErrNotHost        = ActionError{Code: "notHost", Message: "only the host can do that"}
+	ErrSettingsLocked = ActionError{Code: "settingsLocked", Message: "the host has locked the room settings"}
+	ErrSpectator      = ActionError{Code: "spectator", Message: "spectators can't do that"}
+	ErrGameInProgress = ActionError{Code: "gameInProgress", Message: "only the host can do that while a game is being played"}
+	ErrTeamsLocked    = ActionError{Code: "teamsLocked", Message: "teams and roles can't change while a game is being played"}
+	ErrGameOver       = ActionError{Code: "gameOver", Message: "the game is over"}
+	ErrNotYourTurn    = ActionError{Code: "notYourTurn", Message: "it's not your team's turn"}
+	ErrNotGuesser     = ActionError{Code: "notGuesser", Message: "only guessers can do that"}
+	ErrNoClue         = ActionError{Code: "noClue", Message: "wait for your spymaster to give a clue"}
+	ErrNotClueGiver   = ActionError{Code: "notClueGiver", Message: "only the spymaster of the team playing can give a clue"}
+	ErrClueGiven      = ActionError{Code: "clueGiven", Message: "a clue was already given this turn"}
+	ErrNoGuessesLeft  = ActionError{Code: "noGuessesLeft", Message: "your team has no guesses left for this clue"}
+	ErrInvalidTile    = ActionError{Code: "invalidTile", Message: "there is no such tile"}
+	ErrInvalidSetting = ActionError{Code: "invalidSetting", Message: "that setting doesn't exist"}
+)
+
+// permission returns an error when the player may not go ahead
+type permission func(r *Room, p *Player) error
+
+var permissions = map[Action][]permission{
+	ActionNewGame:        {settingsUnlocked, notSpectator, betweenGames},
+	ActionRandomizeTeams: {settingsUnlocked, notSpectator, betweenGames, teamsOpen},
+	ActionChangeTeam:     {teamsOpen},
+	ActionSwitchRole:     {teamsOpen},
+	ActionSpectate:       {},
+	ActionTakeSpymaster:  {spymasterWanted},
+	ActionAssignRoles:    {hostOnly},
+	ActionChangeCards:    {settingsUnlocked, notSpectator, betweenGames},
+	ActionSwitchGameType: {settingsUnlocked, notSpectator, betweenGames},
+	ActionChangeSettings: {settingsUnlocked, notSpectator, betweenGames},
+	ActionEndTurn:        {gameRunning, notPaused, guessingThisTurn, clueGiven},
+	ActionSelectTile:     {gameRunning, notPaused, guessingThisTurn, clueGiven},
+	ActionDeclareClue:    {gameRunning, notPaused, givingClueThisTurn, noClueYet},
+	ActionModerate:       {hostOnly},
+	ActionReplay:         {replaying, hostOnly},
+	ActionUndo:           {notPaused, notSpectator},
+	ActionPause:          {gameRunning, notSpectator},
+	ActionReady:          {notSpectator},
+}
+
+// authorize checks the permission table for the action and logs denials
+func (r *Room) authorize(playerID string, action Action) error {
+	p, ok := r.Player(playerID)
+	err := error(ErrNotInRoom)
+	if ok {
+		err = nil
+		// only the host's moderation works in a replay room
+		if r.Replay != nil && action != ActionReplay && action != ActionModerate {
+			err = ErrReplayRoom
+		}
+		for _, check := range permissions[action] {
+			if err != nil {
+				break
+			}
+			err = check(r, p)
+		}
+	}
+
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"PlayerID": playerID,
+			"RoomName": r.Name,
+			"Action":   action,
+			"Error":    err,
+		}).Info("player is not allowed to do that")
+	}
+	return err
+}
+
+// gameInProgress is true once a game had any move and isn't over yet
+func (r *Room) gameInProgress() bool {
+	return r.Game != nil && !r.Game.Over && len(r.Game.Log) > 0
+}
+
+func hostOnly(r *Room, p *Player) error {
+	if !r.IsHost(p.ID) {
+		return ErrNotHost
+	}
+	return nil
+}
+
+func settingsUnlocked(r *Room, p *Player) error {
+	if r.SettingsLocked && !r.IsHost(p.ID) {
+		return ErrSettingsLocked
+	}
+	return nil
+}
+
+func notSpectator(r *Room, p *Player) error {
+	if p.Role == PlayerRoleSpectator && !r.IsHost(p.ID) {
+		return ErrSpectator
+	}
+	return nil
+}
+
+func betweenGames(r *Room, p *Player) error {
+	if r.gameInProgress() && !r.IsHost(p.ID) {
+		return ErrGameInProgress
+	}
+	return nil
+}
+
+// teamsOpen keeps the teams and roles as they are from the start of a
+// game to its end, only the host can still move players around
+func teamsOpen(r *Room, p *Player) error {
+	if r.IsHost(p.ID) {
+		return nil
+	}
+	switch r.Game.Phase {
+	case PhaseAwaitingClue, PhaseGuessing:
+		return ErrTeamsLocked
+	}
+	return nil
+}
+
+// spymasterWanted lets a player take over a team whose spymaster left
+// in the middle of a game
+func spymasterWanted(r *Room, p *Player) error {
+	err := teamsOpen(r, p)
+	if err == nil || r.Game.GameType == GameTypeDuet {
+		return err
+	}
+	if _, ok := Teams[p.Team]; !ok {
+		return err
+	}
+	for _, other := range r.teamPlayers(p.Team) {
+		if other.Role == PlayerRoleSpyMaster {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaying(r *Room, p *Player) error {
+	if r.Replay == nil {
+		return ErrNotReplay
+	}
+	return nil
+}
+
+func gameRunning(r *Room, p *Player) error {
+	switch r.Game.Phase {
+	case PhaseLobby:
+		return ErrInLobby
+	case PhaseGameOver:
+		return ErrGameOver
+	}
+	return nil
+}
+
+func notPaused(r *Room, p *Player) error {
+	if r.Paused != nil {
+		return ErrGamePaused
+	}
+	return nil
+}
+
+func guessingThisTurn(r *Room, p *Player) error {
+	if p.Team != r.guessingTeam() {
+		return ErrNotYourTurn
+	}
+	if p.Role == PlayerRoleSpectator {
+		return ErrSpectator
+	}
+	// duet players give clues and guess, there are no spymasters
+	if p.Role != PlayerRoleGuesser && r.Game.GameType != GameTypeDuet {
+		return ErrNotGuesser
+	}
+	return nil
+}
+
+func givingClueThisTurn(r *Room, p *Player) error {
+	if p.Team != r.Game.Turn || p.Role == PlayerRoleSpectator {
+		return ErrNotClueGiver
+	}
+	if p.Role != PlayerRoleSpyMaster && r.Game.GameType != GameTypeDuet {
+		return ErrNotClueGiver
+	}
+	return nil
+}
+
+func clueGiven(r *Room, p *Player) error {
+	if r.Game.Phase != PhaseGuessing {
+		return ErrNoClue
+	}
+	return nil
+}
+
+func noClueYet(r *Room, p *Player) error {
+	if r.Game.Phase != PhaseAwaitingClue {
+		return ErrClueGiven
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// PhaseLobby is a dealt game waiting for its players
+	PhaseLobby        = "lobby"
+	PhaseAwaitingClue = "awaitingClue"
+	PhaseGuessing     = "guessing"
+	PhaseGameOver     = "gameOver"
+	Phases            = buildSet(PhaseLobby, PhaseAwaitingClue, PhaseGuessing, PhaseGameOver)
+)
+
+var ErrPhaseTransition = ActionError{Code: "invalidPhase", Message: "the game can't do that right now"}
+
+// phaseTransitions are the phases a game can go to from each phase,
+// a turn that times out before the clue starts the next team's clue,
+// players can only end their turn once they are guessing
+var phaseTransitions = map[string]map[string]struct{}{
+	PhaseLobby:        buildSet(PhaseAwaitingClue),
+	PhaseAwaitingClue: buildSet(PhaseGuessing, PhaseAwaitingClue, PhaseGameOver),
+	PhaseGuessing:     buildSet(PhaseAwaitingClue, PhaseGameOver),
+	PhaseGameOver:     buildSet(),
+}
+
+// checkPhase tells if the game can go from its phase to the next one,
+// moves check it before they change anything.
+func (g *Game) checkPhase(next string) error {
+	if _, ok := phaseTransitions[g.Phase][next]; !ok {
+		return ErrPhaseTransition
+	}
+	return nil
+}
+
+// setPhase moves the game to the next phase, a move the table doesn't
+// allow is a bug and is only logged.
+func (g *Game) setPhase(next string) {
+	if err := g.checkPhase(next); err != nil {
+		log.WithFields(logrus.Fields{
+			"From": g.Phase,
+			"To":   next,
+		}).Error("invalid game phase transition")
+		return
+	}
+	g.Phase = next
+}
+
+// end finishes the game, winner is nil when nobody won
+func (g *Game) end(winner *string) {
+	g.Over = true
+	g.Winner = winner
+	g.setPhase(PhaseGameOver)
+}
+
+// gamePhase works out the phase of a game saved before games had one
+func gamePhase(g *Game) string {
+	switch {
+	case g.Over:
+		return PhaseGameOver
+	case g.Clue != nil:
+		return PhaseGuessing
+	}
+	return PhaseAwaitingClue
+}
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecfde96ee2caba380edfca115fbbcfc2034ee296ce0720c1980009103c6d2d6d798a6d280f1b9bc11c9d7b7f5583476c20bdbbb75efd7fada55eb14db95cf5d4330f55ffdbf182cf30eefcf8df8ee325eedef8cb0cfdee210456eaeb41d70c2d3bd07d3bfe2b027bd4ead9db757e74baebd8dec5453bcb3e146d3bdf3ba21f85bbe45d4fdcce8f7b3afede99ebbeddf9d1f1752fe87cef3c8766e747a7f3bdf3a1ef1c3b69f8a213760dafa19f65183635af0f70a627a6dbf9f18fce5f9dbfbf7756890eecce8f64b7b7c9cdd2d6e330e8fce8c4f0eebf2c3bb203cb0eccf4c77f95a6e384a1130260db5d27fc6f3b70bcc0fecb0bbb861edb9def1d211c79c08ee1577433f1c26017ee137bf7971376be771cddb7f1159c32be8ab68e6de1cb5d18faf82a0ecdad9dfcd30be1eddf196851af9f40773adf3b9f7e023b6c19167eff2f2facb6f1f5ddd6d0133beec2afeeaefe08ffef054ed7b7fd6abbd8dbeda3d80eba20747608fc5ed8f5c27de20134b1c4edeef4c0ea7cef0476d27593242297fb1d6c10c237e2646786c1015f7981839ea581d9f9de493cdf86537eb623345f63ff89a661a4095a4433f4a39d1dc7dd4fa02776f98173f622741f24ba17d8bb2ef0e2843cb04fe86a974649985f7475dc23be31bdc8453021f756f9472bd68b1bdbacde5a0cc7d1fcc583ae1724f62ed041d7b68eface8aebcd00f0a2c4338b27aeaf97eef2d721380978eb3fc57b230176f1836f71c50d5986ecceec956eca13885d9daedc31dc43e59ea399d27ded930928c1e9c4517cf5ae1b6dbd53e77bc70eccd0f202a774d9d5e3802edf431a7ae8559e7881be4bcb4f5cbbdc5b770329b6741f2174b577bb700787d54e2dc6fef3530761d7b577f6154aca09fc9e361913b8dd30d22181ded93405a16eddd536d9e9410c59c5d75a77a31000bc365f79eb681b98d17c9d1335b7e946fa2eaef32527dc7900e86d5ffb124fbb5cec7a8b9cb27c3d8aef6c9ae85b3b0cba8d4d5d3bd27f5137dd4f0f24f6eec6a830dbbe8bb5dbbe615b3f27045adac58915c6b7c48513023d70fe0a774ef7d48dd3b8bb0f108770f5d8257fbae6ce6421cf71131f903fddc4f623c2f3739841a9a003a7fcc88cf6e5db4f3f893141e48f023b4976ba59e9288c11cf283f824451beafbfb2b33f816d26c0ab0e29f60207d89fc073dcca57e334367500baf6c9366d24fb2e7e22802830c28e1310a2d98535398b1f43699b095dc3738acb24aec9621f8a55fca7ebef41e2453a020a7af0af7d98d856b4f3824437106f0f1099d50438bc44ffcba0973fcc464c9e41711bed42246a6b923f8c1100a0e283c70eff40ccb6c93d812aba72ec53945f74e3344874089fdd3e48f074c855d7c4fa13b9cbe1a727a18fe4ebc52f047017cfe314a9221861ae6829f04fd13d593fa8bdd8a7a48ca8957bccde321de77b671f78503d2daebafbe4937ea8de3fa1db58ff84ed0e766085bb6e858232f5c5d54d5767a8fb5a4521486996e26eb4467f208ddddb2e13e9d71aef77073b5385aeb473b7d6e7f516975ad095c637660cd1d40a62f8cfb7e35877dabaab1082b3478476b35db40b4fe98d864cd7855ac195569e15e8577e469646cbef90cd62c6d8f42bc2d2d836f73bbb6b7896b7dbb74213233454013ec39d7fad5186c3b0c37bda05b0bfbfbf773eec38295951d028fa276484c414823f97cc21f80b94777f7fefcc420b8efbc7ff76be6283cea0f149acc0fbec5c219c85d6ad765d27fccb0f2dd45cb277b1870c4bfa2fbad7f9bffffbbfef9d4f3cbf7b2cf01fdd686f00cf84ada1e10eff5a76a27b00751060239ab4f9de89bdb3ddf9413f50df3b3ee42e3f18baf7d87bead13d1a3df927e23e3f3a0cc550ff4d71ffcdb01fd4e38f1efb83a6fe7a60198aa658fabfa9c71f1464255efc4f0b0205c30772c71fffdb79b60ff0038f8f8f0c4323b8a30f3e3d3e7eefcc81176c3b3fb8ef1d31083b3ff8478ee27becf7cedab33a3f388afede11e015437def2c2dd80ff5bdf3ae5bff349df09f54e7c73fa8efe8bfbfbf77fa709c7164a379afe01f9ae329a6d7e3f9def7ce3c864f98a71edb7b7864ffef7b67d6de9cca9a67d3a3feef7b67f8c5f6036f97b80def3cf1bda71ec7e643826d57c5120c40686e6334cd01d8e2b5e951fcc3f7ce08e80efe41b003f4771a47face46970b7209e1f1374218689bfe71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc71c5fc942b86303f38d2adf3456339f3cbfcdff78ea5277ae747b0070076b8b383a4e8b278017def7e874fd78ce3eb4e1fd820f7f8304f3fe5f1a11f1ea95e8fffb75d3ebd8acba7f73b5d3e8f2ccbdcedf221f3bbdbe553b4bfeaf2797c7a6a70f9c035f8e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9e3f2f9ff84cb0779667ea7dba78b8cd59bfe9fbc55e604ea314f7ce605ea3154b3fba7f7413ffe601f7fb04f7f71f423d77ba268f6c2fdf3a983f886ff876569e404c1fe1fbae2ffe17e9dff877d78a4593e73b63c3e3e3df49e68b6d7ea00621f1e292e77cee4336c7500b5b76f75003d3d3cf41e7b0f970e200cffcc03443ffce75c40dd3c01ace2092ab030fffdd2d35338710a3f0d466ae2a621c850f5d394fd2db875ce19f02d7622e0eb0a5f291ed5def87f8e8914749e7393ced09b4786af1dc4e1fcac327caaad068f43afef98027fd695656430bd07713c018630a27445036fc7d011856564b2835855b60fa230890d66bec3cfe70743e0d337afbf596e5c61f9a209f8b9763082456232e041fb089df9f3e0683fc7afe270e01b0c474d150be8b2155acfa1a3fa7cace1df585d5e52fa73e8ccce33f25d376f37fbe81f271fa85da22a9340977b0fe2731f8ddd10c05e5bd1a12683401f2f1ec4e7c5d11c3b645ea3ad067f4f69ca60fb0fe2f38cce7e537dbe3755e691ed83bd7646634935414aa7f2e4908f57e05df87d43907cebb9f2fd043f0b9dd9b047c3b17d2ec25791017bcd7f724476be31d80928e04ca79abc70ec1adc169b593a3f8bbdb763c40f9d70a3cb9c6fa4fd8dc9cc0fa6bfcefb99ca93d892d717efcf3e5ed8f91908f5f7adb1941adebfb1b6632bd2c6cbf0cdeb9f67e91af5a30b52a4312ef5e6f54fb3e73e799f764ddf8a8c4de8cc2a6b0ce1baaec0db12a49e35a45d4300016caf32d2de12a414f7738a0c7f9d94bf31dd88789d183e3658f1411cce85b717ed65b955d173cd3f1d5465f1200a27a0058bc76140bd16f8cd5d85b7381c4586323898c1d2352edff58cb104c4319acfc6604e0773133ae2f9e5343f9bdcd2ebbbba4c1f0d7642a93247d561af32275a5bd15b5d51c9f7abf430dbe4f450c5e5807a1587fd028798395cf3441300230ee71b38a6a9ec02359bd7b0041bc14a35655b9bc71c18fe2269c50561e4990c1f5bcaf2069d6bb1c18ca8376f90627aacd281e96774d7dfcf57eb8cb6284be09337af7fc4f834c9d6f558c3afd31b7947f3f9bd35a4cfba1201f87c1e0c7af8fb13576592c0f479daf0170fe270769e6d66c7d959ccbe85e8f6cdeba7e6d871e09c7479e18894da5b6e97c272535bc7ea734a535c6a2a2f01a45335870d7730fc753b3c375168b01a30095c557fb4d1192b355869af3d435c517b737fc640de56e10b8cb4d78634652993fd9bbf8c4c66e41982b425b8e6a9f27ca7b1938325735b44df67f13c7b9e111a991c4c7f09e01c66c1a0278e215e83ad389c316fcfda66ee2f6af4527bce4aa9c9403a186c3559732df994e32ec159df602709190b81cb28d6656bffe60d369acc519a22663021f8a81d4c9fca71d582bc6e782c70d7e7536395d3c406c2c76af85d13a48d218c52d4a6a0bd1a2f79610ade5d87f72c9d9dfbe7195e53cf60275b4d111fc4e7c9d11e0ece06738ab4615d4eb5c8aff112d8e34582c6c44e5c4b0007c8af0c9fdf6b399fbf6bfc854cfbd88246580fe75b4d9ec7aa02793ceaf3411778465344c23b2e70bf58d3c67580787c724d7f9da0ef7c445b5d99bbaa7f02dab00d57ef83dd7ce330ea59dbd661577b4e9bc11cd1992648beaa48b195d3c0e4a02a930cd78f063b8f32d89abe1443dab37ccc0b2c066c2dc1816b7e9c0df133431ea51a03f6050f19b89ab08c0cdfcc75811a0f39e630f779d7f417ce34981cacf41eb97821d78eaabcdcea321734f1302c0f9bbed5df5b028835e5f69c35019c4de1e4da1fa1a3cb5caccb1c92fb0d3239e799657939ff68942b394fce75a1733e27cf601d2497f0bcf2b54fa06e54e739f37199e7d4657105eee95c69e3ddd5f74abcaae0e918af3d8319a5dab0bf377d9e15871ca5cb27f0e6bb07cb9752bb3c368f2ec9e802972b3837e4ce559e72a983d6db4f03f18abed630b7f124503d279d9dd5587c7e6167c3a333fb308faf1f15ba4af2395f697f3116ff722c85eed73a96d37ce3c4e2b3789a7b4767b699d137c6d2d8fe722c8bdbbca92497a77213afa8d3ca6caf0db9b04d0e56f976c3ba97647ac378f7fa735d5e5f8ead90b5b5b1f95aac2b83a3262ff6468bbc377dcb7b7d164fb3155cc3ed693aec9fe6e77574a167367e7b906a8c18ce362fe8bdb78f17840ff3d5b65dffadd188e69f22733c00da904b33185eacf1703e9c9d4576b66991a194789e6dccf4edb95d5faac1d63704703405695be067134d12bd79d3a2e354f0b84926b7af4721d31ad6f3b97f84ff5a74c823f9fd6e18233ebee28ea6cf433e0eae7d5b6524577b7e69903fcbc810d67b733c3940f9a3c98bbabc6f809febcecf8be38cf0a8a137a70cc60a0c413adf234b2f656293ec2ac93c2fb395456c47633814df1c72e49ad8a3e3c1c16400a5cbfc1ee1b27c823a13a37dd474a0cd1ad4ec89589335ea42de96ed697679d0182bd6949923321cb0523a50651a7c5187e088ef20cdfd0935fb9ed830177ac6b41873d586fbd03259fb933a7b9b1cac3e273633d4110fa63fca60bc37182ed7372c810f34e604ccb4bf317c899dca966b7c5d8f6c5c5f3590a01db7afebb3f38f7bedff6b3a7b6123fd8cde5981f33d3a7b0997a601b95e0d3c4b59525007bc0a2f221f67e72de4cde737c4e767dceb9d7d43dd4e952771e193f8499bb60ab3369b7663299333f60b2c81e68f6883e0760b3ed106c4511ff960eec287b2ed9ae964f7d9b0edfcbd8cc7480f96214f5d469aaf452a2bc5d6784b6057f65354ecf4d860cc4c4f8a357994629d761966df30043e78db449e86eca5754b5b32ce60098c00aecd64bc58d323f1e5f4be4cfb21a4314d9953a2a0456630a75e3f42ef1e7fa8c564bafac03398d3b63c26713c3faa72af617ea7c8641789ae2c8191eb62a7839642bd38f71b94f0a090e1e5b6aa0f6dce415de73ecd3e4cf66dad56e037f5331b527abeb4c51bf5e6ffe9fcea18559ca4e08e2055d12c4f55a61e29f6ce3015f783e5fee21e1e79fa89611f7e5d988aa678fe89797afc8d71aa27866178fa4a9cea8167b93cee944df14a9caaad7d5b9caa29b9997be83df58a8465bc1259c08ae9fd0958fd09585d0d5815d45c44aca669f8ba1646b1a62c29ed237c1d6e2731943065c932dc9e10679dae8bdf32ad01b6b7e47599dbbdae7d695bb6e687c00a21872d73eee11679d4a146f632df2ed2c579fb3af4c2c3d089fa862c6d75f9c5791568d71ed2252f9f7a9c8d07bd57c2694b96526ab066a6fde7defb92f78683ef35598b0d1a552fd3a810871f426b83df5b82b4b7884552f53cce8e6d7d67ef9becc05599625c85e63c38dac37ab4036a5b14922e0b81069a005c71e826aae224258dfb3443ef12893d9cbbe6b8dfd0ffcb7176e51b1886b924fec9f75174287ff7aad7afd2f796be09376c35348c6b0d2d27479441620a7c6a09a33d8e9af4dba229501380703a1ac288d39409b1ecfa654b8d7a1bae5bfacc2db7c2c2f1dada36585f05aca1959a98c268a3b3b386792d7a935503ac8785d5349511bca1f5085af1f932aa9acdff0cbf9d692a153c6e5d0ba845b5d3e47c83a2c11181e7465506c71cb6154d4b8b35a57dcd34c5493401a49a3ccfb466a875d186dccbc6ba3104c9855a5831af0abc91d5d602937cbcaa2251467a059fd96564092750e007b67c5419f2c05e160d6d9e47c5632eb6e068be161bc8773499a38a6f552cfdb30569479eb57c6b99aa32774611920f6289b5e04e8e5fc288b684a76b6342385bacdf5db02d79c3112ee008ecb07ffcf7d702e20e0066d0402bad73e9d7bc1dfd63fb5adc37c7420e8c526d481fa10c34d39c86b6ba82f9ca9b07694fea6570d7fc1380b462faa3e39b37c07f33d91448674b00bebda2733c806b8ee9138e1f59aef7c36c58f5acdce40d6df8d2c2abf467b1888a635a484c468ab50db45446674d26d62dfe4e3dba89f1ea063ee45e8f96319885fcc5590e1e0dbfeb438b4f55e60065aa7cb4d06d16216f9025c47b95d325d429a60a94d9c9591426b4355e1e32cf7465bccfeb3b68a20f71f07445ff60750150da8ac66b8f69b9822bc4ab80bc009a7f3a58e7d0d1e453ac2b83b3aeccb27e2abfeb02d86a82b4afc21347c9ed4b7cc1b2188ff50a4ed4b26f0a3e719981d1d4cfb0162543b4d96f6f7ff1dd256dfabd7cfc45860af252b4d1f7dd63ab67d064fac7dd3ac1c74b3b9fc9f58c816b29cbc3353e9365ba6832b79d2ad5395b027fbc42df9ec12e2983a1aef3c73bc652f1e6de5c9f72e419c28667346502790dfbe60d425d5e6e3522c7735eda14c52df492ba4e4eb5f3efa6cca69c0f85b6320835993b67732de89f87bc3ce3cd9ee94bae9ed2acc14eb6791b5f4a2d016c54f958e223cb83997fbbc8a09b9f6fc0a8e4c1b4ebb490d25857f4fa29a4c39c9f671edb2175fa0959dee4617c307c9e7acdf56011ea5cbee6838d259f287bb5fd226d95f53bb02799829e21f01bbd4546fd92f10b27d7641789c1a889350647ad34ee5c4f562645365d06d386b568f026976050cb98bac6c3efd72faf445cfa6d51a29fd65dbe36b639d46d40836d744b978d3579c4682b3a35183e29decfd720bd22fff24c37c2dbd89b762123a59036a7320d4c76ee6acc3ac67c68e4431ad6656e6bb02679161d60df1a334a4afca5664f6f0b1d007b6acf9a3261207d407c6bcce2bdc8ee2b4527fd8907ff95232785fd3007d0de36378dd11c2af33d5c66ddbed01759b7e7d0c19920240a9adb5ef767c2a890ce99b839e3b312e553997a16ee97a293458671694e669e495cd8b79303d2b74a999cf09baacf6f6db20e17994ab7a23d4d593045e4d657653a32c6db07713879d685d15e635ebce9707086f6d5549903d3073e81f1bfb2ec3dd31fed8b79f78fd3cde82c42fb945927287b9995086d8509c4474d41fe141209e05cd5e7990caf6c8f8e0c7f096c08a70bfa246b0be1a22c925ac4b0b2ceaa3c39439bd91248465291395a85b152a5cbabd1e9fb33407f2232d51ef9c57836f2201ca772a68373075390f69799c3d50cbbf6ec966658de8ab05d64d590f72d480702cfdeb316051d39191d64329fce32f0acf1c4358239ca5c7cf3321c83f424a9af430ab68beec9fa227cb4b4b679d643aacacbc85266c8b7779df6fae5ec0488afb435cc79ab63c9c77be83fd69539e46f942d9f00b26f15c9351bf5894a06205dc8ecdca792c9876333bef65bc79adbae57787105df140bd2e3c14a07e18cd8f9edd9f52d3821bc34441b5b2a009e1b330bb1bc3edfc816be99c5d016d56f1d772d52df5e0dd120879b70c2d7151790887ad957528cafe4832dc1e27a961ad2c3cb9912995f34b7e709fda0ccdd87cc5f6608234a5b39097c6f3aec27482e65996059a69132713501d026b4513f0a1924b27360061aa4a34453e66755b6802838e73b33ba0b9d4296ce26330a2e74ceebb454c20d11b4d3f53c87df7d191f93838d755cecb72cfaf40cb641dfc8e9325f336eee7db9c2a025a3fe223bec1acc6e65e0d4a3efaee6c75779b6e6839e262f2e74a42ccba5eafbe91f5b336f6a1510983fad7f82efafc3e9c7fa38591d9de907cad48e6ef2f3a6b9de8fa3b5f7aabeafbb33babd753b3db1838bcce7abd516b7abc8ca7223d79f4bba3d43e0feb399535732352bf68673af3e51e7a32873d63fb976a39da145b6206d2f2b8848664e3d9b7facb14dd57d96df3c579c59ab1ea7c33e331b1e9df9a61f8bcffdfd1be18915ddb4ad32cbe7a11c6ecd1c6cd78516c7928e728f4e93d34c561d58f88cabf1104253d0ee0144778ac5aa0e758b86fe65209d0865fb39faf3cba53cace8b7353df63c69ae426bae14b8459795b14c7d6cd3ea3277b494c53db2a32c77b17fe65ccf1e35e9ba3e88fc8cd84fd3a8036571384c0b653bbbbf27381119c180b68683ec6fd5ce2cfbe41bfc786fe3c2b6ade0d8cff2a5d5ff3ff1a545eff7f1a5ca5a64b0c3baae37b856dd59c1b3bc1f8c8b37e46d29fbfd4a5f2a3b019026bfca232beb3f847ad8e450d1c3c675dfc2cb9d95b1753b422a7c0e555bbbc8fa1c0f0e7a49fffe9a1fe18aded6e43f2eda9ead3188216f21f6779e5578e97bbbce7f1b72092e6d4105ebd7a56ada3babfeeef5c55cad7022ba6396c129b996bf4e4c9f3f181f5fac0cadc4f3afdb5115be5cd1fb491f8273bacc08ade87a355a16d3269eda585d47b282756519439a45735dd1fb924c6dcd53baa772ef8a8e5d8af9df9df14fd5760b2855292f5a71e9ed433ab655fe6338637990cfb7a17aa03486d2ae00b51ca6aa2c74d5607e30c61255d7e1df7ec676941ca293e7d5e0b59ca29fafbc2cfb5fe615fc2bf2204476e01abe14ffecb76ed82af7d2f8557fd22dd9589f53ee9fb95131dce243bfa8f2afacc9707e54650e18c35c0e163b17dce9bb9e3fab376d9c4bdf68a5daeb762568856697ad74d2aa2f38d77cac3765f52d78ddb2b5db78fc57be53de15e24ebc5e3097bb96d47c23977a5ce117f837f4b8165fc735d97053c7c9aa327085c668afc9c7426ff251b5f90dd957f183375608b6f293e11cf2b8adc1d010af124b905c238b55df49276f1f3f412717554a3f4927ff364d5c546c35e22a940b9ac02770dda7ca12a8327dbebf42a77d9d737ffab598faf9366d18023843bccc74c592efeaba1d9adb3f6d156065b8977d8cb7d6eb86cfbd191751c5940669525e24784e8366997675ada42d5e238ae071eeb3ccfd084d31cfaff22f5cfd43539a3c4a5a2bf9aecbb02fd22a1758b27436533aab96758ddcb6ae56a34e372eaa6c42b118b4e341d92f54e56f95f8cfb0476b32d51e2f0ea8fbc7f61c3ea8bee41739638352ce63df13878e8768a319be7b8d9580c9ce92acaa57958f0f6ffec887365c537fefe9d62b7603b9ccd9bcea2fb856b94baa3089ced99c1ff92b7498c6f87fa55ab89aa398e5feffaa6fdf88c5d7e7de56c57a7557b12bdff8b77798b9a19f5c89535c8f8534c13ef74571c0f0afef36d436f6263f625b35630d9fef8d855cee6ac37094e9f3f1cfd14436167e6f8d2707633c730c61e469b90cbbb2cb40e99daafff24ebaabe428ddab278a249e8cc6b0873ab556f08eebbe92cccffcbc0695b86336c66a7ec7f142f7fcd02af80b5761c6a8e7763b2ab74173594a7cdf084f4b7aec79febced698258978ba5b87f99d7173e9ea96201d54f22e413f34192efca359e1f75b9e7cc8219e63ff228853a0894c3a87e2bed1f5ebdc1872a6baee96f9d57efe915e5627fa50fbc0617fd2c8634abc9939dae2c129d91382d98b8862c9db3fe108e3cf78bdcb452fb5afc2c35d8258a9dcd8425d0d26d963be6109d21cfef2bc1a166ef4f0e168e139fe79bc15693cda85a9795005bd65255a681990ecea60008efeb1f27458e595b9f474d9082d72cdfcceb633e79fbbda6b1fcdaca602fb0ecd35f68ebf8ab65c1a576c511468f8fdccdbae0ea79d5f4e3134f73bfbc2ef8e9179f5f44e735b8bdde13ffc0b3d4fd6756e329de7f6675defefeba6086a298c707a67490115a89ac2e9865fed405ffa90b6ead0b2e91725114fc2ea06d30bf4186f43ec6ceeff7edfca0091272489be7e3c1120065086bdc66f8924c651a58c24b32fde84146f864c812a5ae06a8fdfb6ac2e8b2c4a284afa1e8e405b5cf94470417af0912a3cb733055b022301d0e22c307942e8fe2a932dfa8f209bc7fbcec6743117f5340df7354c675cd600e605f92b414a61f8ef70e0dffe753a2294b571c4381300066b0e4de579383768e285b1900d12b8c86f7d5040a13a800017183c72faea884ccb13e2764d868ca84b23f28cf60cc07d2ce2b6d6bc18b6578a5620127658012a534413aabec2432c74870b6c16dab29f38de9832352503c91247e97bff30215da544f0707cd1b3c1bcc122aa289a6ccf6a670a2cdb49f88a365a8ad061b43189d4d066cc4a1556e1788c21c1763a403bc1d934082de50f0ca3c6da202099e3519291557830534d2a0c266094ef6bb6ffa0018fef22c0a3c715a0c222398a3409631dee282b7e1c0b357036898bb162a7c18b8a2c0012b1de0e09530da6bc3c1591746293448c5311a37da1e74ea9560b88e5ccb1f11f82d72d899cc3cd59501250a27d7f02d5a952d08c37fa98ae6e6bf7903b4feefab090e063195be3c2284cfa63fe345dfa5acf1e0fce63d1d5466b9d783d95e654e074b5efa783b4a6e6330d4419523d71ef2b12e4fce53b64f4ffde83c3df7f773af77caeec54def69ca92b57c2e704c7c3e65df764c76b2795f59a1355e1ecd73789832f3ade171678399ef3465b1d7e5a783c9f01b9d91a8a90f0ed38f97fd7cd843fd4fce95fe9de98a2ae0f571e31b70bcfe3c365869abf9687bcbbdcaf0c99419fd4b551ca8707a66ca57c6315bf5a8e9665d1dcfb5718cc93302db09f333b09d1f542601d6908b8cf4299d6e66fbd9b0322ed84ff0be391eb2f5c6bce0e84e5794b3a0e71fe2904a087f887599db2163e799f24c76c9198274d60509401e850a4b3e280f078ff98dc9ce3c5170534d5679d19f9f213c90936dc5a1df7e519f28c03d45d7199f3b45861f3bfa7802b40de5e9e325658e670fd394474e4f33e50283e103541c2d00b88e076385faee6afe28d1e513f7be9e87aacc05ea47789e3df7bd96f17d1b3ae1d39421bcdf099f50d23da12db44db200b6efab496c30236898407e17abcafc5c7e06610fdb1278a06d9a757901e7077996abcb1c303dd1c1df5ef313e622a92940ef0e11cea06d7be0fb9322f91215469ba979bd0f8803ecc035303f8763c9932d517f17c9e5a683b6e56167e4370d4ccedc0025133f1f0f9993231f9bb08c2c6f1069cf54504fa29da403e280a5bcc27916e1221d8ff0e13379af34df0ccea57b38ee70b6e1d6ba00ce22fa4743f8faba2c6dc5b106cc600ee587a3065bc7f2f9581b8344957b4f53c6491bfa110c76b2d3e4052a6e33192853dae406e2d5af06c31f0cb6ffa43f637cccfbcbfa84306297b42a1fcb7d6ee0baa9fe1aca26daf017cebbf0d284c39bcbef3e79efbe0b4c7ffd34655ebebd0ff97076266b58fa07fbb3209f6024ea7d657daa3ee4f97150d053c15f2c28abc6d0d0c63c65b5191c0c863f8a101ecc293658d381f20ec251cadb0efa3a69f73ee4dd0a2cc95aa9ab01a52a93405316fc84e63d4318edf5d46c9ae75155c051958f7b435e1f563e0f3ee451aa322e3099adf7be5d1e0c1f1a7903ca4807a1269f8e997cd505fe8c64e5f978503ffe3d38e83e0f0c79944e7d1a881b4ed69579640d0789bd1a7ce00d5a06ff3218295115118e13c1bf61cd5d71bc744ddf823a57a030935895b9dd241d84a62ff955d902e7e0d26a06779f47f09c3265dd883b1a63e93c6597a926af0f863c3a5b82944ece9c0c754671343f58ca64a3ad308eb6ae4550c2cfe7120f22bfebcfb36f2b8167c5d1f2208e10af803442d56884369e6b30863cfda3f704ffa91b6efdf1b25cbdc1b5d8f49c95dc73160cbfd5640e392ba6c30165314f4e163c8332d414a46c5dcf9abc762cc63d407dcbf006892a2780e872aeca2e1c8b01948e8adb8f8ec1a88e25b840574407ea3146b03c5fac052a5204a9c92ef6e2687eb465da35d92572c86b0c60b4d500f242b26df8006d656da6034a17461419c3d160c0de1aa2f125967ca27465106bab012a8435d301a4654a17d60e29d8dd135df26c423ea3cc290d8def74368e176be288821559be74168517c708a4444541b265a82a0b089bd4f4a5b329f07b6d3c732ce109cd571438da902790fe205fcac6918d191dd3a02a83a32100341723ed874bc5750d38ee8fd011b7a3bd2ed3ae31ec9f456f8bc72b83b40976689d64dab5d0569fcbd4c6eb440218050c340283ac209c0488e05aa20d8754453be8320a8ec1391c4c660dffba50dfd5e4d3013fcfe6c71f91b315ebb74095a9bd3802076bd1043fa807417c381d2c06f2fbe541142c5a53e66751e839af69ff2442fb0139caac505326003ed795591d9678fcec80cbe82b5b9ba93718a12d9f651eeaec2cd4d7315c07aee5f3913644ef5ef01d7138704d760eff4586d7cb65752193eb855d83a33d34bf7df84f8e2140bd840ba6858c7e9a325bc8eb21add5e9cf33374d34c99565169ab3ba1a900451decfe084e16c85ba3c0f21ad69c2dac9de791d5b07d35f9e5fa18de10d5c713c07169c97bfce9cc940c3b4e18a821b61c73ca2a5405734607803cfbe58b7816bf83ca5230733099079707ea7a8ceb7a06ef70e8a201ad4617465e1e8d0664a2bbc0439e535d4f7c2c101c79ef301f589fc1b084f810ae789f10ac1046dd221cf2928f3d50c2ff0ef01725e1674fc784123d8fe3ac077e0f854845f5aa4c9a72dc4634d99e0440008cb170c6f4d999c5f57d7e6bb64a1cd6daf681fc2b0b226ab010aae98e9d1d1e4d14647f4436c4128e7c8f107781c275797170ed4e14401ece1ef73f34d99af06681321a8ef5af2c935bd4160fa608bfc09de802439505971fdfe62ce2f255e05f108c240990391e00a5c0713fe63d08660289928e3cfd321b267339a4b3505fe9ba3c434b245a4abc1f7c6b318dac9daaadc06dacea3bd886c7464a7efa7fd26dc5a647c04e1989956bfa3cbdcde60c837030075e5bd86da5d5d13b8ce28808df5ea824f9829a107c63d584c0f7ffb597906e7b5a3b113c81f0e9ad74fe785cc607565198a63cd357d70b0b01c8c0d7602e183f153981fcc60024c16caa78c6f3e39483f1678bf050f91ae280a1cf24da040d87886743682d3e81886a93758e3790c28245fc74bdaf439c8e7de8d823e68d3efc5a45fd7c43ea25497910e56916d488e62dec268cac453e5e3052fd405244349e121e6b508572be343f3052a8b0254d9f8f790bfbd6f2557659cac9f4455b61016b18de6cc9d75b41d2ae4690867104f33b26f643ca1517e64b808d75efcd624bb4bf30b75b4191596cf8877d478a099429d85e372baa8ea09a1aecc0e88ef093c0bf929c1f5e0623d91bf09e1fb192517b1f308addd70e059cac281fc13cbbad1de2ef19f5e38fc10851330594c03f66ae0aacc3c82baa4258c6263bc75a01d8c65961441b9879332605ff3d060f8335c6fe2efba5847d3978e502781fc09f31c488f606ff83c4a1416850967b24b176de7eb1d21cc32bf175c2b3cdfe1d141ba01a2d1122d7bfd5bf487e03f6167d9bae63694ca409d42da8b82966ab214dbaba3f32e88df203f37204ee0b581ba7c64a683bdc12e9c57ec4bdb1a0cd4bfb8834592245419ec5fa14e116c731d33e76d027fc90f919ee4b6f0e901b44d6203f9ec7896c02cc7214b0089b622b8f7d2f83ee22d2a83368c41f2cdccf42f346ea4ff24aabc045f815f49d70486703a135c8536730cf9952948290e14c36fb8072bc3eb1c0e604fde41b2838c8324be113dac84ff393d5cea02f8f818af0f6d8709e411c6787e10852580bcd590c15ecb7535dab50590583255953f422657b376cdf2d790471cec4367d619cf81fa273a0a698a3799dae817f204d222c8e0d300bf410cf937e4cb50578336cf12e2bb00e5ee00a0626345837a7862211e85bf0fd71ef9a3d805e1e944671d0f902e4ac694eaca7c97cb767602f5e40b9a24c93f0e94dba200a06e8a78a2a68c684de6369a32c33a3ee4990806a3d8649e1c0db61f63dd9ff07be41fb1640edaec11e415c80e41eb8e0b3ab0ac9f0055d6206fdc433bd210b6751bf8093e5be036900734f253bc564867ccecbac81acf2a3c3b83f3b4e0572d388ae18a613187f0ce644dcbba36cb4ad597a03e94f1ec267cc63200f38edc36d405291105f8bd23594f688bbd64f4ed488c0b90fedcc023207e5dca73524043f4b729919938b905ea321a803665b1dea783e93d7bce6456d5a38647076dd32d8c02c89f613f886796f49e2984656ec39e5c95891d55999f55641bf4f6e20b68d3374af60de2ab181e105e0416788d71ac04e21be4c1868c376db504cca7c9da639d00eaf22bb8664f8e2e2fa1ce17693e807c2fd421dd13bb70ea0d269a377073197ab9beb94d6ab01067c5aceff671121a681ba74ae805d1ee659f846f21fd83c07eb081e3c5ed9e2ee8d662a0dc047b2ded392b5975b01fb75937c53ca81927c8f17a67541835de92f1f79c165b11da856bf81ed9f007c7a4a06d0cf1423ec5253d0ae90613c45bac4cff867c9ee813c4c73a74a15cdb97e251c4c6d4085e217907eda988e01aab0b5056f3fb067980f511c145f2baf29d0b9ceb8746e127c8bffbba6a9cfbd39495e2dc373de4b7baa2667cabe4bbb72a05de93f4ba2f5a7f9e7d5b405b6b0c0ed62a97f58847be9f8f07f87be173abfbd7a1ce41c3ef05ef00a098f1850fbd7d9c0d85ff4dfef90206ef827326efe645812816c538e7f29cf264c5d6efd0079d19719333f70effbe0ff96c5ef938a7cc32b28ab84229c681f4985855b6debb77ad5db940b3ecb73c85b3aa5f038eff54890fa002e7eada63b865b1188b145e82bd3996a849dd77fe7c8a5579028ce1c03758919f900203c3071b9d41f22278df7291ca24c83ffb86f52e57f50bfc2a608ebf416049be5bbc3b49b358ab8562ad13a867296eac41db5c707871f37244715106f753f5c55ef870731816785029840e1a7443346e44ab1b2ac80a5a0c864f26676e05ff7e797ecae4603014a449ce14d6fc8445c9b501d6df4ec0f02d4a7fa6bcd9471fc5c2c8d82a3a440defc9b7503c29a7c76a01b5d9a083e0844b880fc5d810ff40f27d72e616101f90bef11c229e81d6fdd2bf9d17696bcff9778ff9bb43338b5b06261c1b0b75e486f96ebe305fb8b6353c7e1ff709df9052d3e7cb9bca048896d8fe155e5329540edeb7b8c0571ca1b57a9a3224c6b729f38bbc88bc3c6f929b0069609e6ad81f80f0a4810fc0711c494cb4b6619cf86de9f3a9ce546374d0deaac40b02ac5fabc1d6c96323dee0de785a751d1be23deae5b70ee286c3fc7bc8bb28469ae1438dde4af14d8ff0a9dcb72bfa45bc156f242cb6ae8de89f80aa6880d08df7bec5f7d7d7a688ad92dc9ae0229679834f56786f6d3ec586fe62130da23628f1777811f32cc7c18b6285ba7f1acba3b4e4dbbd8cc75ef4679182870b5abf86afa9262f82f72dc27f67ed4bdb463cbddd0f2abe9b9cb957c847c41774a843e3da94c76ec947bcc601b4dd49010cca73b9e4a564dd686348d6a05c3473e73b96cc6d35598b0c5fda4e6574740fe239967cbc6bae4dc55b500e940adf1c09fbdbafcebd897f37e1dca5dcaf144e784df8a03f8bdf16d80e5aa0247fa46735c6de09efb110bf9f2a8383c11c837780f8b3b316f883f15ca2ef66b8e4f206f2097c8cad49c63a419b19a2b1a6e2b7853f3a6b2b3ca6ab387641ff4b5a5316793fcbb104ace14ff41348c856c0be2231ef4f42c9f7930d298c44fd8a43f76d4d69caebc7a5ce59867555179c6c2c654e190c55cc9995ce96c027e26800edc6eb3851c535cf08e6be95e2420d71c3ad3479148b2fdcc71298ce1af6dfa00fdfc2af12ef29157034e246a6e361ba81763a8419738a70712bd18b3cac038a018a21a6e2865beb50ee7a8319d1d15a752384aba48023cb6b42f4c5ac3d32460af7459f0d01907c21a45f16dff306892ef77871d3dfcf57a263c8a3defb4aa4456fc0a8f289867dce56bda3880e43908eef2bf138ddac3d71f8740fed353e2bc1d035c7fd0b1a2ccb00bc11f755da3bab50475a99df6ab94a6883f429f2699adfde87fcd91446fb77af8ff2fd4cec572ebd9ff15f943b07fb81ef1c6fc80be447bbd0ef493b1457cdf81ebb74adb1742ef75f1b0759abd2589b7971366f722c3684556deee4e86e1df9e1cd6f0b744c76d8f6ddbc1fa24f94f3b6aa3adf35bba0919eadd2f1fce6b7a5cc6dc511f281b4d3714bdf55dda174bc78231fc0478523bde4236bdf804365fb82d81262806c092fa771081b05f1f3265901d7e3acc927a0b28b4cbe20d8ab0c4f1b41cb3be8bb3cc9272ed1d950fc367b3e1e0c76800abdbff42ed40d8b38f3b7d9c74ff6e389df669b9f7c3715bfcdce3ff7ee7c287e9bffe4dce72bf1dbfc27e73bf7c46ff39f9cef3c15bfcd7f72be6f43f1dbdb4fcef76d257e7bfbc9f95ae8d8753a42bec9cda3e74d202d92762d3ae6949d0343903656831ed340f3f5e3fe83f7ed686f209b690ec497f94dbdf67dc823dafe09994c8e326e941755bf053b3958a9496405bca64e6d7cbc985feedf4147b54fd2c1c1d8ce631dea5148d69ec08239452a93006de81ca7cffd08fb56aeebf03fdfffcb6fee5ffccdfdcf7e73ff8b9bfdb7e0d43df892fe627c39fd667c39fd667c39fd667c39fd667c39fd667c39ff627c497f33bea4bf195fd2df8c2fe96fc697f437e3cb65dce0df1beff937e3cbf937e3cbf937e3cbf937e3cbf937e30bfd8bf185facdf842fd667ca17e33be50bf195fa87f035f6efb4183fc10a4461f682d9e991dfe1488592df2ca5a1be9e06c31805219c7d182c9c1580d3eca793896f0e42cb37c19efe898ecd2358385a3ae067b4d31497e40ef226648c65a8d2fb1938321ac933cffc633d1a648aa3c89a1bd9b7f6773d5dfd8da6f39df7672e6caf3f8c2fa22fb27359813982af323ca81569607d333bfad71dea96b097c7adde7daee5b695d1312bb683e50ecbe5846f51d7a0fed2f553e06683334066faaf3bee50ea64fbb46432dde551833cb48f3b54865a5d81a6f135d80369af96d05ff5eefab198f1b62b596c0071a7302b77920b21313835942fbd43559c9358662059716cce86cc9a3f88b711ccf90f9adb6a2911f0fdaa09200124d5efc227e9f1d9276df7aaa0cbf3719696fb2d279aacc23c347ef56e6b966c01ec2ecabf1aa4adff2fc600473600473da4ccd6f8bd26f3f8be7357a82bc82e46998df3e7c89155f50bed7d77c8857721948fc0c6f7c7b3576743a68a9f8ad3177a03d9f00e1063980bc5a9bdb84e3e57116d770ae076dbcfd36dc1475e48630da6bace46acc9a17fd6a0d7dcd9f79d0fd11a366fb180c4552833ef99759abffd4839957adddfe9f5fbbc1cd26bebeb1cd26ce37b4e11fb2dd6c18baf7d87bead13dba71531bfae983667eb04f3fe887bf9e789e7b649e78fe62531bbc33c8b53d6de887a7c7c77c4f1b96ec69c33f7214dffb855bda500fdc23976f69c3530cd3637bcc63cb96364ffc134b3d4058e0f6f9045bb6b4b9d6be6d4b9b27bef7d47b609fb277a87c2b1bd84fb68f0df59fdbc6a6be7fcd57f655f1752f68dcdfa6fe4527ec1a5e433f64139c5b03243be4fca3f357e7ef7c8b1c8c64d51d726278f75f961dd981650766fae3bf4ad371c2d00901b0edae13feb71d385e60ffe5855d438fedf2ce3affe8e866e285c12edc27f6ee2f27ec7cef38ba6fe32b38657c156d1ddbc297bb30f4f1551c9a5b3bf9a717c2dbbf4b1bf4fca3f30974a7f3bdf3e9279d0a19578685dfffcb0bab6d7c7db735f4c48ebbf0abbbab3fc2ff7b81d3f56dbfda2ef676fb28b6832e089d1d02bf1776bd709f78004d2c71bb3b3db03adf3b819d74dd2489c8e57e071b84885d243b330c0ef8ca0b1cf42c0dcccef70ee2147f679b10fda363ec3fd1348c34418b68867eb4b3e3b8fb09f4c42e3f70ce5e84ee8344f7027bd7055e9c9007f6095dedd22809f38bae8e7bc437a617b90826e4de2aff68c57a71639bd55b8be1389abf78d0f582c4de053ae8dad651df5971bd19005e94a0ad9dc813d7d74b77f9eb109c04bcf59fe2bd9100bbf8c1b7b8e2862c437667f64a37e509c4ae4e57ee18eea172cfd14ce9bef6c90494e074e228be7ad78db6dea9f3bd63076668798153baecea714097ef210d3df42a4fbc40dfa5e527ae5deeadbb81145bba8f10badabb5db883c36aa71663fff9a983b0ebda3bfb0a25e5047e4f9b8c09dc6e18e99040ef6c9a8250b7ee6a9becf42086ace26badbb5108005e9bafbc75b40dcc68bece899adb74237d17d7f99213ee3c00f4b6af7d89a75d2e76bd454e59be1ec577364df4ad1d06ddc6a6ae1de9bfa89beea707127b776354986ddfc5da6ddfb0ad9f13022dede2c40ae35be2c209811e387f853ba77beac669dcdd078843b87aec923f5d7367b290e790fd12e19f6e62fb11e1f939cca054d081537e6446fbf2eda79fc49820f247819d243bddac7414c68867941f41a228dfd75fd9d99fc03613e05587147b8103ec4fe0396ee5ab711a9b3a005dfb649b36927d173f1140141861c70908d1ecc29a9cc58fa1b4cd84aee139c56512d764b10fc52afed3f5f720f1221d01053df8d73e4c6c2bda7941a21b88b70788cc6a021c5ea2ff65d0cb1f662326cfa0b88d762112b535c91fc608001db20f1ffe0331db26f704aae8cab14f517ed18dd320d1217c76fb20c1d321575d13eb4fe42e879f9e843e92af17bf10c05d3c87c60654bf106cae6829f04fd13d593fa8bdd8a7a48ca8957bccde321de77b671f78503d2daebafbe4937ea8de3fa1db58ff84ed0e766085bb6e858232f5c5d54d5767a8fb5a4521486996e26eb4467f208ddddb2e13e9d71aef77073b5385aeb473b7d6e7f516975ad095c637660cd1d40a62f8cfb7e35877dabaab1082b3478476b35db40b4fe98d864cd7855ac195569e15e8577e469646cbef90cd62c6d8f42bc2d2d836f73bbb6b7896b7dbb74213233454013ec39d7fad5186c3b0c37bda05b0bfbf6b3b8efe031945ff848c90984295dd47ffd181bf4079f7f7ff6b9b906ee262f351bc09ebaf74f9947eb9e5ffa9b4cc3c410cc53d32776c6dcc303f58fa07c3fdf5c8320f1c4731bd5fb9b5314d733db6f70bb736e69f1e997c1b619e6128867e64da7736e69f98272e6b9ecfb07d67e3d6f6ed6ea04bd7d103f7f0f894bb83c842641ea1ded39f9d8dffec6c7c85a95409bfd8ded810244a1c675b89f67951008757721680aa2cc3376f909de9bb37047e43f6b6df9bc288d24949fd6bdaf7ca5b9f8ade11be7fb26429b53f42470ce60095f132dc447ba63c71183b26239d5139179853063b713566bdd718895a294b60ac1c2fff7d0bb6a2b77dfd5c6d1fc5e1d3417c991f0c9f4369be96f0e464e7ae0f9df0304d071fba6cedc517b01747786be1a57c02868cb65e799da64f87dffeef88c6f1a22bda79e89f80351c64db813aefabc1d660e6341acf900b34653932040995ea2d0230d1868e979fc7e06d1fc9fbf95625f7f6814bba4691e157fa4165914b0130e233e56802bfb1641a18c102c13d87d3cb845bc98bb056961bbd1da35853160e2a91dae2b2c48fbc4c18e2cdf2a0b2781c53df02d68b146ba8ffe5d05e83edeb7072794e291c9b07d794a47ebfe0ed3ccccab78a12daaf8cbb54c2ebbd7ec4af08cf05f81b2e75fd4a5ff8fcc91a0c40515efaa5be8ab25134ae69fae42c4898ad3a6f52b6f995b5c2259ff5f93e9332cebb70a756fa89fac26b84b776580be8dcf9fc3d13e1fbefa72b3c86656405333237381ee94557d4bbe655c763548ab0867c61c2dd4f13b8a40095eb96fb42db412eef1e0bd93eb2b44e78fb5d293b6bfeaeb1902d7b152b32fce5c14a4be391b5dd17fb2a9fdd5e8c6b4cb619de66e5c792929def7ecf3c33de5c2a5fbed2f77df8596c4d50ccb73833fe2e3e84cea743dbb617bc313618ebeef533043e287817097de778894b7ad78867dc359eea99c5357ebdf6a5aff117d8bed6c7029597de3516b26d012a792dadd5c4853866c8e0419370a9e85d630a4aef91f256b384a79acc6d255492749ffc284a984a6bef4bec129fed7d4f1fa514863a2f580294e2e14bc32c65e62ebe22481bbc453b8d4a944ab027291750de9c40917e75d73871ea929c6d7755e6e904dfc0e460085225cdeb2e9af4f9585bd1e56d9adafb16a48d258c2883fd62dfc57b251c226933eb4a0ad27dfd923322a772e5ddcb716fcbbf8337923a75e73a36a65dddfa8688b675ba8b3e9b52af4a384874800f99df6aeb2c1dea2e5ca9a75035f729a132d33bc74a1f34619d58386daa0106a554233a4b69ba6bac78bb32993b5bca2c3149da5303cd3c17ede6a5ebd9ddfa6f735ad4256c1678bbfafbfa255bdbe7695197e31e687e7c9f0cf1796a2a43b97b39a635919bb97ebde677aa7227ad5ccadcc46012d75e35ccdd1f9d35996c3f79175e54cae02ffa43e5ecc257faab94c35ff4572967f7bfd06f7379fce5fc49497bb625dc5d3840dec1671ec60db4c19db5c0225b3ade27934c46634bfd39c82640a5b203d82eb517253925e12d0deea303549e8b6d94b23d3996c00297c57ea59f8dc1a26d42a2376ce37e9052f68cbfa332f63529abbf6f9df03b53651eebf2129825b98ecbf427e41b93192ee1bf0b9ef552fb846c0f94db5b6b224fc4975364b2cbcc4e2f9db3bb9ca16d66efc7355f9739a00de918bd57c03ad5e425da82f74e7da9d83aa4c45be07aa1ad38eee3b11e2e9546dbb0e57396d6db8a2f84e8e0a834ff6e1d332897f2176b6532f303d49b90fef3957e2ef44cb43df13cf70fdcd717a52acb0a6f275b3cdc85dba46d898ea18d07ed4a7cfeee97ecc2ec7813c46b4304775de622abff1fb59181e68f6863bcc8b6fd3b6bca126de19bf917327d6a8df4b53e2f0e27d936b3a9b8c9e050d7d3fa5066a5863c8a8b36484f40bfa92455bbf8ad2c77d137b2ad9abd0c366b066d378bb7e347e719a36d6a497af5e0808f03e3ce433fe3adf07bd2b3aacc69553eee4b29d470ad293390c0db31ca64f11cea4524f5bbded657e5d359fb885f73990b61b1cef5ed5b7d239d7e5d2a5598fa457a3ec2197f149bccfa311ffba54e7d734ce514ee2c45bcfe1d6b3ca1dbde295fb78e0ffb4d0796b23c683edad2b07cfc5869ebb3816ffa7c228e47b4a64c3851d05255b6102d0dfdc2af360d3437e7038a4b99fe6863bd68a9c1d082e98f024c8b6ea34f591746677d7874c4006f8f0569b2ee6bfb35fd977c7790cf621848abd56082ce82867de2727db27d3bf15538ff413a1e49683bd1159c7f3073903f6111611f2ef6f3ed0d86db1802d8e888be9c487ca6be91737cf363c034998eacccaf07305ca7c3fc7cd5bd2ecf77787b8ef0c2df9ac13adb7a1ff941b376b8afbde58f20ef8b497fc7f2367b6d6b87cea05d6d1f8701f5589eeb829d0055590233c5dbcc11bccafc975f9aaf4afac27e1831cecfca15cadb3962ffc847e12baecf27c5db2266be18d81787b7705891adefc7c5fcf276858f37eb0f9d6f0bf1f93393c7ca9c6c2d34425b2493f5c5be2009c3f62bf33504c9b57c09fbb6bda363b31494873cd1d524c4db5f103ccfe2086d2f8ef4928c76218ffc3a3e611da3844f487779f3fa1ef24339e86cdef671e0add5f0366304b7b1af8a3b183e2ec78374fe3adc3aef1fe4dce33c56c501430654e6b3c2fa51699df136b40f22d1875ac7322af9a894593696b4d8064d2247287c694c0defc3b1254d38f06109a3d41acf9c5249cd6be1d7fad277f79a628d51aca4820311d2f99799af6a9d9fd3dedeb7007c71e8a2f724c4ff33bce7b22d4b9d77af7f7c2dce6abec091dc37b646fa11c213bcadfce281e8594a992f4ca16d026d8ce790f8e4e6070beab919cf47eb877951c916ca680ce921e226a373d4ff33b659b2b1f779f1f9059f810d6dabf1bc38435b18c59a328130d40c166dbfbdc7472fcc69d587f3415b4801431950e8288accd7979da73d9e00b23d732ec76bebbcd0f139f5952dd291deb1b8a657f07b1595a2c6707ee1ebaacfbf7be49cfa3ce62a25ba82746128af36fa0895521278a36b4807159f5f332d0c227c8e7fae07358eaff0657e09374989aa8bfa40f8898e3a3d01c82f8a7252b15136ac215f62d1b14079f9a688e759d5e1a472b9e7af1b5fe5bb5e232fcfd617fb045f2afa733ec665d9cfb876d131625fe1bb266b4596300f2bfd60bee7547c981fa123faa8ffeb6bfd424a498783175dd67c5d9ed3c678c9153a77a34ff5378e391bcf753c207abd837c9b8b669dff67c688fa23634365a4287f81f84eafaf7b6697348e292b65fd297a7e417616a6676c73417ade90ef5d5fdf51e69f1d54fca8b53196fdad1fc44efb29f8956d0e4243659fef43d90ebc8e9765fbf1eeb197dbfdaef197ef9be7f022a163fc506ccb8980e12fd7e8988fafacbd2fed35bc451f5af7467d196f51701647cb08f2412d90f62a8b6c1dc712400ce52ec44d4d5e84faeae8e8deb68df76138d1a81f8267db0751d8c6a2103e884298d16219ef9f0d428bc487fe5af3a95f837fa479fd10fbd397594c794fb654de177e943efffe41219e60f86bafa46be0233f4659ec9bcb8e1fde96fd36a23f89e13a173a41be257de3b790ae28f01b3dcd8e7f2bfcfaf888110e97b95338ef09f944d8013aee43f579465b896dba4525be3f0de6942d135b1e6ff598f957f6591fe27090c7a9ef6c7f73ace458030fe95063aacec7f2f524478fa0ed5a0b3930714d46227eed2fc9d58d2e8cf61a233de377316f453ef933e4f728a6d04247f81b664a8e4f19231ff96b53bce16bb4858f804671367f99d1399a2fd245c6f888eaab34478e8947f328fc42123a0ecb9fa3b5cb8e35fb92ddcab8aee15b6081df253a1c9a37e43d74b9ff9a3cba778ccf786b5ffa37ace59c36d925b4b77f06766f26a3b1bf016628be7221bbf1313dd8f798c52cd091d20437e4c56b350621ee5579b95d2a1a30021c1fd0d0f1dd8e47b6fef5a6c35bf2bc1623c17847b6840c9dfaf732bb86c032f729137a8572c8d79549828f8495b8093b01e4783357f34b3e4159db7d8d3e90ff8bc13912d0161cf93a7d8bd7621e8ddbdfe4b3f8f8ef0d5e8f328ea80cbfd77c901af8b84e14cb14853c96f97a23467a156ff0b104933cbef0157e5ce418dd9a3fe261c4bf4dc63f22c73b0673724c2f5c2f846bb12ea3238dfe337ec520a7e783e115b1e235da1219d1cd5655966e0966b1c198a1268c2875856d5d129b8cf0118016b0c6a5751a23bb0b1d71a5cbaaf32a407be9655fcee52dfb0bf091b4fc7ecd2e0fa63f0ab41577d694e5c412a404da63d9ef2b79918f6d5ace251e96ec6fecafc06d7c705e2a6e64425dc8b716643b9ea890c7c8fff4a22bea4dfcc4edf35cc37bf506da447397902f06c1575813387241e95994c962b1c813be634c959ce0bbc674471c0dfbb83dfa6c0923ca4cc568ea83bde14be96ab49c7f0cfb3cd952c5cbe2a726e4f95e7f2766eb9c3d4ffb3b11cacf21f6e35eb4f3f983a1cc729e56f01f7e9ff9f3d6e4386ca81bdc8b97a577506e38f251a263b7b01ff7022f5969a342dc4c895e3cec131ff832b2bc3bf8dcb08f636ceb6bedeb3a28c2bd11ca852e721ff7bacced3565b2d61417c59d45ef02974af10d27c31b47934f676d4572bb95496ab0057fcbd64f12a41eceedc7f336947900f914caedadf184c26ffeefc39ff4858eb4456b5ea7d3604eab0ca4e7d97f9236ef83d550ccd7d852d0fcc13298b81a83682884b01387035e14a418ceb7392f9e8b0c9f0326405bffa07834e64ff4593bc7a8a661e844b93e6330bd303b22e657c01fc7047e35fed7f1f926fcabeb35b622539086787be81c8f9b6141f42589c01fea4b78ce85ee62087c40e6437cc35097c87859463b6b47cd6416c95381ef92d87f467ba2f442cfd01c418c6a5408bf26f92490a74d2ca4b334ae1b072c0ac162a5417831dc19f991f0d80a9d1a8dafd007d071932f1cb0d2019215f7ac91c580a3b69e1ca00efe9acbc0167a46b9e0a314f34131aeea447d686b95c68b741394c396bf73b7fe57c68bdbb6abea9f0e2a137bad7c48d6762b066cd0b151deb16a9734c270e4ebe9600aed18088f720efc3dfa9d59d727a52fcdf742b7accb4e91c4152c464ab5d56047fc32e8c83251980074b4e1cb4858a5f7ea9bc5f79b69e722bfbe818f34aeff0739becbc973ed517ce3a2bf0bba21f492988cf92b60fa6fe350e6d3c3718112be34f09126feb926efa3b521b186cc7745e67ab6e4f906ae7949afcd8ec2c27184022e38965093293807c7adbc8365cb5779253a869fbaa01561e2aa4cb23628541f80e4d6e76a7b012ba4c748487fbf1b3ee81d85bc33968ef01d8c2be4a82f94f382ea3a7c5de6b664ae44f6e7c781917991f7d788877e401d346b4bc6dcc0739791c9e4f5804d7212f96a512e3dda32b0de1ff6355902887565c9892f1a6df8737494c37facaec9897c4be6b27c1324d7a17e96e113960510cf4771c94eda6af284d652ec1f9da46150c2bffa9c213d16b8b34073567465004461141ba5e373354122db162ef171aef20964db9c6aca2cdb5a16a8f23c4472b4ee73aeca25827fdac144c7b74a94387620dd1edfd2414f7c3e3af38fd8b187f1ae34769c9fe6df59b714a0ed9691ac7e1d3bce6bda3fbd127d48c4477a42d8016b3840fc65f61c3bf6aaff243eaf1fc5f176f75a92a9d9b7f3dcee670a8d63ea43fa3f6d4d5fdacb2cb0de8af6d9969a7bbc35e8fc2dab51447a8b50d808383e8c605f8157c52ecee055c4919bece3a0cd3e7e5d447c0d9732bb01f3942a4d46653f7c3d1f10fbdc4bb527c5fab4c581211f2747a6f57971bc4c2d79ddf84e79fbddd69cb4e6f76ee7da911c14bc1daf548967dea303e47e7b6ca3d4f9380fdb57e3c005fedc994798e70660ddf9eedc40927fd8f02d697eb465da35d9e5e53a089a0be793cb1cb2356f350e7b879d5bd8995fc383daf7afc4e9bff45eeb7cab78f7253c2874094cabc380aad02aca0b1fa19ca2b0f0735cd06c7e0ca6cc96fd544ea4ac389c1b75bca0d3b26cccdfcf68cef42574b4592e5383b951e51bc09a0688a6517c6ee81732ecaa4c5d5dda4f75dba35a13d577215fa83e2bf01ffba5899d868fe8de180220478957732130ee5fd68161dbb8f23c5b4b16caab05ae9b0db31a89daf75c733c00aa3271d1f14602ef93e7488e99859f0dd54b95f55578ff96f671dc89d88bf8387be29f1dcfb25c6ad416d917e53871d15739368cf72b1891355865c7fa633bd4acc69571ae01f28f207945e5350325bc23df28706451d69bc05ef39f2a3ec629e9a7055ea88d990e307e09f07d94df8d7007d9c7a4bd548d4bd4bf91f9340e9ab08e9ae188f0ef9cd9dee298d47b38a5f14b03a8a79e73dc43f0de36c210eb9d331c3f4a0791e16b286704d153b54f4c333e3a1ef5127ecd70c9b66f770c019c2da247a0798c515ee62ba61ba8cf0c52831d1c4c6614a31cf75182f583b2ce236c1d5dee3917dfaee93c24ff6961fafcd160e759ed5fd5af4a27908fe05c7dbaf876c62731ed62d9b346f9f4fc59958f8ef841f1a2c0d186702cfc6cc33ec9b9876d4ee76930a04dc6092fc791d3204f7cedaf8d360ec253c4879ae0fc5af26336ea2385efa6462f98e69ddca754c179a26b56708623478f1dabf3cbf114e557eef111a9786e199e7f14bc30eb0beae311e6d9610b5eaf1d7c34e620979f506643fba8c243fa8dbad904e2ee2bee272639ab996e868fc35ca31cd94b5b3b9beb586ae15d38b716e58140fe2248bed547767c5ef373e1a3c0e3d89bbeb46dec738cf286313f4d07b1266b14821f947dd7fc0278ac98cf0c6347f4ac33aef5e979cddf41bf39d00e6aeabfae6f97fb27fd3de33835e4b77c0ce9d7605487c868680f15763cc9b1b57c292d68b24ff67021fad0d8c27e5cb255fc9df365f17311ed8f83fa08666ee107add63a4d7d6c677df8592d3b5e0b8bc16b548a13a1715bb81e0ccbe4d2fc5fa10e9be71b35d78a94e68774275190f6705db11cc88f18407ccf6007900f44786d2417fbf0b1cfbba8dfbfa2bf5dd7a5739dbeac4bb7f64bf43bbc0f5036373c8e0c678a230b200f2ee7da4ece45ac10e5068f506d657bec26cbefaffa32202fade7d20e553f5b2f5c2b896d2823906263d8f7275e2ecff9772277a7011a6f71243bc491e22882875abcef0257c5f1e9a9fd9b25bb04ef6d90e9ba977126c07f2a695bde52b516ae1e23c98f58a88c83c37535c342ce885e3f14bdfeae3892c1c530c075737b35ab45c5b0a50dff141932a03479e189cf4f8eb87f15034f74debc41d37b51f55b5bafe4eb78bdaa975ee8238d3c1a1f030db21a5537d37b902e58e8d1a81e2e320289d2509d7abe5e387eb01d253817bd9f88cf2f91387c72e69bfe631187b8ccfb785f0d125de6684b90ce784ed5fc910f5cd7da10bf20f37a3901c3b7281de113e44303aa3a973c2f7e270e27892ef7506d1ee10f93cc2f848f531944663ad81b2ca9af9417b1282c0f70ddcc721d1f195b614b901a41bcb679ce6a49ffa98fe9aeb86b737df04fd9b2777ebf16f76a598b7b62909f8b167d02e72ce5767ace8b8359d5cf96f98ef1d1f904cfb6e5ba0d422728b72e2a6ad32b397c17f981c5b1f7246700c329b3f587aa3207597ed685cf8bc4ab890e8af801cea3db36ee0f7021efd0fe2e4456619e477c31791e3aad29cbd6b137f0c94aaedc6b318fc61cbd8bf9f8d29ef85f484e139679d7f626b89c13892157d624cb91dbb6ed43f0e5b1dc7cbf616df091fcc5183ee03df3b36b53def3a0756d6ed762a33a23fa68307c2c7adb3a5f233c7e826a86c4d1e06030c78caf11996a1d4c7fb980efb7ebecd86668f69364f604fe3b1d0e8eb91d349ec5c48e7d2feb8a281f4a5e6e456109b2a37a509f25bf398a95c8a38d3ecc7cea4b60429d4b40b1f943968798e9ea44677dadf9b07bb90f7b98f9b09d8a0f9becd574b0eedb772235583341793943b707f5f2d96a9bd757a1b8e4d045b629e261cffd4771bc75de877dfa2d1d70af695cb1252b7b6078505783e3e0363a9cd378020c9070ca47d13ec3bb4b1b04c35e665d4b668185d774f158e81a79edda059e21f8e5f9d0fd8ceeb03d384a7a8a9470ca8aa36c6550e8a078bfacd2782ab8bf43f83b16333b65856d3b10235f0d8e03e05c76bc079c618f68c396a8bd3506c7c2974ef63958b5c40c8631e4158eea8944b72dd96e5ffa0e072c659912f97af55b46feadfc9d9ff8de12a8ca32bc093f6191c1ef45934754460737d6c93704703405697b6b2e9a7f8accf100c92d3c272dd695c1b16dad2a7e011f60bd5590ceafcdb817ddfa7ed61fe2cf789e19df80f632f6d71c6b63688ab90465dfb6e87c8e8f24bf0ced6313b5e1feeb19f2f8c159dc607fd6d23fb95a3a700de198f314c8fb2bfe8821d2e75c713c3fdaab4166cbe4f449fcc0551f2dd6e142948b7f73cd9d0c1682218c02126bcc7c07914674799c730df6a2806ad50a3f68296e46f4d10f5de0d9cc4707e18b8fb6cc742572747e30c3bcabe4e3bcf43f6731fc8a7f3baf3521f567a59cb4ebb570f7c46a9aeafeda6343455e593dbe54ed271beb7df1933bc6508cfd97c01de9fe39bcb11d91c1b9d8afec12cef7ecf3519d5b798fb35bf1419ccf4572f3b6eefa637d2ceb29adb0afeecd761fccaf8dabf0c760dfea57f64421fe8dc21fbe6a8b27903aeef21e6ac48791d5e135c4771beb01ef59872fef3fd2e877b8b6cfdc7d70bf771c953508a8ac16a1e283ce78b528a038428b2d86dbe098c1651ccf82f682ac45862f6d3f509b56fccbf78cbacc21cbec09a81f4837da5cc6202ee296246e97edbb210a2f8e216ca1dd87f32c88fe4974d72c2e42d608ef9baa5fec49354fa18cd7d699beb90845ff1465b5f2227ae7222fb6889b32c09afaa53d3a4ae35c65322cf3c979251996cbcda383f6921626a92acf779ab23c8bc2283558ece3d4052932bdc13ecbb5accb63126349aff8294b3a6f933f0de78ed6fa2bf659591579a4188e247f18cadb528c5797a8bd59d30d8a78b91815bad329bacc5d9e98a287eca256b8223f3c387af78ca32136e536f91a6fc4b2aee348303faa72cf7b2dd90897fd647a0fa243548ff59aed5d95d285ad3614a3529ece45bcec025eb7e6fb1c57d65c45ba25b75d306e64088b9b31332247079ab0a8e32fa905423849f6c71dd046bf14cfab8d8df8fd094ea0bde736babc44fbb195edb1067e83e2e386bf7c4636d9d08df522578abfb6fed56f4e2ebe056d28bcffca2ffa46612f95edbe12cf1b1d91fdfc8273b35e855354e44c52052f6fb4f1514e1c8aeb15b9f468efe74b3f2af19b18021f4c7ded606e2557659c10de2f658e3283724e7266039f0e1a8d70b9beff1cd9535dcafd1eafc3c9d91446fb326f3418eb033ebb8c079d0e1a85f72b9ffa128372d9512cad8ff84d694c1896798e1192ffb05f1cef5871c0f2911f9ac01ad94a6be497f0aab45c8ca5c5ef3fec5f7e7755f6f3f7a15d82631f426193556301b53e900fa8de473d7e507b07dba4b577b65e252e30ec870def54edd8da11d8282653f8694aba96583b9a3aac8c4743b487637097c76d3f4198401a417531901fe8509fc6fb5a5f1c4bfe06d7d76ba1a1269cc86516ce5d96c87ed9555f0d1facd9816b787539dcf7b29ce72c868debb64adf09508c9ac434d17590e790e4bef1dcc78ef5fe326c50fd6c1eebd96a32c4f309c0fb9dde39ce45699d9ac6762cafa389e396ab01ab2a33476300a3c9bddc16b6e4b5237a66f59d0a5c71aca9092f27a9e8bc0e6bef56690ded1334f5f13e46d9da583217eb321d595007f5fa5d7138f5bc49cfabe31189a7917d57b7b5ef88d14fc03b8f0ddf4beb37d6dec1b8bea8e3f197f115c79478da1ade8bab83329c318f41fc70b0d5e58583f66e12467b93b5a09cc53e566493a27d0d01daebb5d007ebf8fb5ae0018a0f1f4d5f4232ffb5e0ef594e75d490d3e741dd5312f8578301fb2217fa666d5bb566f3666d61b9e6fa6b7585173544757909d77f3c71557629983e9f946bdfb4e23a86b6e932db87319f27b20d185599e0dcb8a23db4c13c935da6d0a67c7d7e89a6019c0fa05e87a29ff1b9b2ef59cf7ccfc2d679277d4e7d12d77c8e1d7d55c91f675445744c6114a17df6c79a9bebd2ec2046b5ed1baad031496e822a8334ef93e8b6b32a7da07a5b5de668eda38e23b89e5193e7074d90a4f5163c1b0c7d34180ec10a8d05c4476545fc674df0c2df483505e730a07dd3982590a4c964c1f08929f07ba42f8c07aeae4c8c9954ec8356f8c88af7d11ab7ea3fc5be9dafd5f54ab373711af6777c2ce5bc55cebd69dbe3f1ade8d72fea568b1a62bc972455c86841daab0cbfd52469b55acf0f86323818484f71d1b85e0bf9ee4d4afb77beafc49c2f6a32d70ab7ac3dc945bbb23f28f229e430c2b4fd3fffd3f925e7f0c5f6ee80cf596b3f778fb4c94fdce31eb2f3f618baf7d87bead13dbaf1d83d9af960d81f3df607f77871d81e3eb2ecda597bf4c3d3e3637ed6de13396b8f7fe4289ea37ed5517b4ffc43efe1b1979f8547b59cb1f7c4b31cc3b08fe5764d67eb91fef273f29887c7c7a747b6f778f56cbdcbbe5705a8b313f5a8ffdc817af593f4be72c29baf7b41e3497bf52f3a61d7f01afa21c7f1dd1a2039abef1f9dbf3a7fe787f561acaa9ed517c3bbffb2ecc80e2c3b30d31fff75f7f1eaa5d3417533f1c2009d7cbafb0b1d02ece8be8dafe094f155b4756c0b5feec2d0c757f830f17f7a213958b474a868fba9f1b74f36ffd279e46dc76b5f1e9a5d3efbb97cae73ed88e6d249cb574e2f460ce1efec38c47f748cfd279a8691266811cdd08f76761c773fc931c6f903e7ec45e83e48742fb0775de0c50979609f92fce8fffca2abe31ec9d9bf5ee42298907babfca315ebc58d6d566fadecc4e0ea83e20c60db3aea3b2bae3703c08b1274c86476acb0af97ee4a877b0716016febe9c2e407dfe28a1bb20cd99dd92bdd942710bb3a5db963b887ca3d4733a5fbda27135082d389a3f8ea5d37daa283c3edc00c2d7cfa7b76991dc89cdf431a7ae8559e7881be4bcb4f5cbbdc5b770329b6741f217445e7a5c361b5538bb1fffcd441d870ec7e3381dfd3266302b71b463a24d03b9ba620d4adbbdaa2c38f237c42f8175aa3e3e42f4ee6bff9d6d13630a3f93a276a6e830f21dfd59bee3c00f4b6af7d89a75d2e76bd45e914f628beb369a26fed30e8363675ed48ff45dd743f3d90d8bb1ba3c26cfb2ed66efb866dfd9c10686917275618df121717877fef03c4215c3d76c99faeb93359c873dcc407e44ff9e8fa1c66502ae8c0293fc24789e7b79f7e428ecccf1f057692ec74b3d25118239e517e0489a27c5f7f65677f02db4c80571d52ec050eb03f81e7b895af9283f8bbf6c9366d24fb2e7e22802830c28e1310a2d98535398b1f43699b095dc3738a4b74f47b5916fbf8587ff8a7ebef41e2453a020a7af0af7d98d856b4f3824437106f0f1099d504787e647c06bdfc613662f2ecffc7de9b3627ae64eda27fe5c4fedca72dd945ed5247dc0f965002c20872580b9b8e8e13185ce09d32a80d2e7045dcff7e63ad9562b06bea3efb3d37de38fdc14e0d8986cc5c63a69e87cc6dfdbc6653fbc6f2af37dc00bf0546602968643f84fdd0aabcb578d8d7878d8bcdeb6a3ba5f6797e596de575c2d6c54cfca7b07768bfe976fdc4f6f5dd99d070ef8e537441ee17b7cd0fbc142a8e970ffd47de0b53d01f07ead9bea8b7c6c7f9cb6f815dfeb875f1b2fd1c7f3cdfffc4bb9be9e7efb1de37eecb723a5b4e2fa35fab55afabd7f82a6afda436172463bf5aaf31e93faafcf2fce5a171857e506fe9e79f7f5ce3bd17f483ca3f79631aa6f3d586fe9e1e369be9e27b973b1384c50b0bda4febd5cfebfdeb4f2a5e5e2cc92bf841adc7f96afa83d31c697ce73ca959518cdf3acba374f3307b797eb8b87f9c3f3ebf7cb7356540930bf079fdfcf4a34acd18a60bfe4abd155def1f6fb8cfffce41d1ff22451842a1331ef4bfff4667c8defde3ff1a3af4905b39d09f0b17fc9f96d8b998bd6cb6eba7ffb95b3fcf377fddeeb73fcef4bcabdde47c3e7cbcba6c923e1f2ea36f667ba20f2e4efe76f9fbdf68fb4db62790e8ff28dd7375157ffa7448f7c467e99ef8cf4df77cfcd0a45a7eff18c5bf5f5d5d5e7e2fedf3e9e387d6c7cbd6bf9ef6f97819479f7ebffa51dae71bd7b627addde47de28fffe7123f819eff6dfee738fe0ee7dfe7778ea99b637646867348ce8441709e9d39cdb248ed833e905d491dc8f69936391e7af38bffab54c77b093fe892df349443a3eb54c785cabcca4d8e43a3b0a46df46831af1c9db758b4c1ab0e5ed37639a0632edf172652e0e25e5fc35edb5c65064d31f6d0d7102bf0555b533dbfe5fa902bb0caf735a6dae50a2182b08d4e23b633af34e4fb42470a2c24c3cc2baba3656a2019b8685e40acfb1a8b026233d27191d2b3808fe858a9c1a49957cee5a8808ec7c6baa89522d26f4ccfe508f40c98ef5357990276758ad082ccabb18bcad4e49ffadaab6c6c6367d180c11eedb76d548c1cbd8f57ddcc17a98951a1328ab65daee9f8c0e5c9c82dea4c432b753ea763438b65730d6780eaee9aed91c622875d9d692c6f0c9a4cc37e40d7c36b3a46f72e26992f9486b22765cb61ae06765767064c8630eb6b8f0a16b48f60f3646ce0031d1bd0352cd07dabf618b89f32cbf5ab1bd9de0fb44f52f0f4ec9535541f0bb0b949c7741ef9dd061aca1b88e202f3455ffb3df5a1a26bbb1c531df1b1c2e135952388cb91d375e6142a5045e12abaf61e9b929ed345d42e09bfa38be8f9920ca2bd32bc6fe87d478e9f3b193a8fc0fbd186f647f20cc92de6153ddb684cbf897d5f57456ab96d124bf707af86743df06a6c78bfca0f258db5aa18e9a81cb99c7f3bb2110e335f5817252595083cd633a87068a81f749d211499cbe9b7981b1abb15760cf5f98eceedb3cc17e87c8b9e1fa94f117d5f472ab3d41f91ea1834240769e6cbd4d233446aa0631c68342358d46d0db186a8a27bb5b5fc662863b94c9d370339decac75462aae838e41568d817104554dff2316f321729eacfb6c6025c34b71ad39efc4ef775447f58b8bc1a9a5ddd363ce64ae52a8350d1332f534be3355aa62edf488969e1a20fbc0d7e4ee3ad6d49befc5e6930cd7e27f365cf60e9a49c51fd42475be5f87c55d23bd8bc1a6a2e93b68bf629529b464b044f63bc2cc097d47e6d97ab49e6cb8185095d77e022752365798351622dd7496ee9da34de325f8e444ea8dcf5759490ecf3b3b9889e2519509bb8683f3030e7fa0e2623a0b11925252814398d12d2836d17b51caaa2d0b1f48f8b5a60a1850811d7219d0807fd54ca188d9211d03de222a763e00d824fe83d46c8c7b16bb9e4e7b9d572ce6aac486fb4c163ea349595a3eb80afc042297a342e46b8abdb5061c1fa322e90e5242e90f5618c1db3a8db880568a8e89910f3aacd6336ae5358d4b906dd37b9b2785de71ae7c3ccb3ace7065067de28e3b14de70ceb43a3583fc6f41b747657e7164a7e5fcd7ac9140e531a9739e98d319765a9a105744f4363335a5039a6763579d226f9ccbc19995d9dbb68de7331929ce5d42774dc413574113ade8ef937f41c56dec7588db3be51a407232e211e505938bfe112aeeb1c2a2c614765457d9f2394379937483aca281cd27b20969079a436512413a48b5de5fb06f619f58d81bdd2b1b1d4f6a8f8b8827c99921ea36df462eb0cec3b99c781d3b5727e5e907d30d0eaba9cf58f023f1f399f9416067d83655b038d776a0344f078a375ad108b11507be164e41486f1847798e310221e176a0c98814f6e333f49dda2ee6868b5c7baee687e8f896a4ae74b452582495d5e39a7eb8e0593737ff8aaeb0087784dc75a5dee13d2fb540749855359f6e85ce627c598f7eb69e627030d73eda2bd92edd6d0c0ae6ffc3e856839b030efd16f5d9eb4ed8e4b80489554d7c5ac2b3a4ed1fdf708341efc1ec96ef2f168d6373e291cfd3e4a806d286dc785b239a6266c93ac659e64d50c9bfb918ed711f44d55281db15fd1018f858996d41696df918f25f43ed6c534dee7e4ab908c76c92fa17d205b4ed7219d9923d78128195868d179e574dd3db457540d4d8456d33d4996a2cabaeb9afadb018fdbf988ede1a2eeba689fd3be8b4c3606e89bb8203daeb5aebbe09536523ad87109160d9a5cea8df91c92deec2254a0e9de31f9627374c187b0b92a6051f7348fc16563237a6423302e0a14fd4efb5d3a0f2a9d5089d7754fa369dbebba6740e5a433e97706582ff40c98aeec93ff9568cca9ed97d40e3d0be4132c0bb97fd2b611d235470ef0d6c5c50d6ffb0d9dd3109523cc491f2f4750d1b85c8e486f5996e9e508518e5119fc811ef933566169c114c6b3fcf6105a5db24b56a135398dc12592ceb68a7c83aa6d74cd7ac845f46cd5d02deac2550a2d96d40623d927dbfba16fa1453ed5d0a0a6edb145933675c0274392538b34ce3c9593ccd729f589f54a934db6a49fab019503a73c9590f9ba30bbba4f63d9fa04c1b752775df74929677eabc6babe215f8bc68f8daa01db6388689bfae7c6525ff0b8db16640b6dd45246d7378efaff9acaaa23fb55d72addb75192ba3cefbb5cd1bb0f34989caeed72958fa140b3a363b33eeba07cc27de572a533bf4f11e63d295b39bda3cbd52def471f78dbf9788411f537d9f54ddfe5d8a5ed20af0383137a26b2c90a623321bde2f28a658974236dd3334a699c86827f6759d6f6ec43b8bc9a92df9bf93dd98181239f07a51ed9018b29c9e7c0c5130511d2b818b8b84a9d22df708f8efd0429b54fee32bf9f507c01bbbaa4b12c721ba73a5a8ea8b4acd3177d07aa749080bdae4b8d3456e314aa011d773aa23119a764eb1c28d0d1d291edb4744df683e31495b1e2dbc46c771ce09d8592ee511855a2a338675197e1fe149bb09fe720c969cc653e1e519ce0807ccc78e4c4f6962e6aa95016621be291ab246670908cc88652e91675e9e2eb7ef30e8efc125f4e34ebe57844318cd8c938c856d397319aebba442c0bbda392dea79522c993ae8706e623cc2bf6c39ce8cf8c6c9bf36432eba14104928dccb70a0374dd56d0b1523a507c1d17d3b9846cea4857a5826ac2b66eaceb91f1938274b58baa41e6d90f1b395595905764cf46902b83007d17a7cab1ae4facf615506922356c4a8b35dd0345272588aa2c483e5d3c69e478c4b1aabc9bd6500db22aa5d800b8f4aad438e843ae28ae5174cc55c6510974cdd3d26fa89ee59884f6ab50c6e52dfb77bc6de8fd34fb5255aa484f03c97245ef51925dd386f431ecd169dea67893ca21fdce60aa8dfcc642940cc96f859cf46be25c9cf6e4dc92e34e2a314fd876d8eb5a5b5043b7a3b264bd0579d50df72ec8c6219fdb93dfa92db4daf29b5697cf57f39e01a4b629c8f6505bb95c15e69a4bba06d9f91b2e69bc483901854eb6ab2ef8049dd77d08f7720a4bedb9ed46e4f3d37d5d8425ed87f61f91be803c2981cff1b38c5c44ef4cf6427c55da46f693b83fb58b0b309eecf1273a47317981dc1e098d4f0dbe1aea08c98e6af0499b6c2ad9bef0dcd6510c101bf6dd4091bc2c472e4fba149767558a64af215a92ec6a94774650293f3f62aaa99f10cb1e1d1f5735d95883a02cf9b92ceb954203d4ee0a2dc7caecfb513045d7b032ce8ad4e4d5884b7a0eafba99c4acec6f8257d427298feb8afd048aa3ad46df078f4dfc6e0dd06f917c03c8aa42598833c4c299a07fe938c583f2ee45a117b56dfc3ff05549c7c83e834f064eee35725144fbe4875b17233dbb94b9ca5cccf741e7677d8a85e97d10751f22956755991aea87480d1cf9ac55993ac5e78626e7d8dc912fa223ea8fbde2f3dedc5a8ad1aa32255f90e2278a3f39d750956d969f886258a4eb538c3872749d88c69ab1e47742545a0d31c7e2109568fc5d9fec04f521d93ad9e6e7a1f8457c62d247d450d580ce4356953d0d9350ee07dc0712e78edcae763657145f3a2b39242e51d16f9714c3539ce56c5e698cc9f7dcd0f1827c1288962303fb94741d6d37c7580f57658fc62a5f976292aa2c0c96a035edef2916927c5255164e626c67f1ba0fd19675b394ec33381bf93e447b8a159b3c970bf1bca39838abca01fb1f55595a34d4de4303383554dff3f921c2bc2b6d98f0ef40fc092aad9498da082df0b98a7d0c88d302af693f1992cf0c71905f2e3f506945c7942c9736daf37b80f27d88156a2ce8fd4836682c39241f96fa84e493e2491a67b1211b42e7482f3576d821fb9b24bbec8bd0be6efc0d88cd88c725953426a98cd42dcb4f2c7123950654995525709c0d7b8ed7e8b8c5949e0b108cc8514cf25ca2f30592df08313a9bd3b5e629c59154828fa854246bfc3c515552fc4a6d89e2db01f9232e8a95c8b5e17c0ae6eaceea1a0c28f2fbe8b8d298929e06d2231ab8ef21c49cacfb91f53a8d65b61f60f34a990597349ec0aa22d45bda906300ab4886f9fa6c2331af388641c9c390ee02e92b538032233e8629908f8179c5ba06f3ca418c2aabcc8863ccca8c2cc5bfd735b82819d0be8b4d4f5705c7769c7ff32a77bb1a5c8cb45d722ea1a218bee4981915e9bd0f5cf26f289ec712819e5f15e4fb02f82a757e3e08db9a7ecf717c65502f6a402852da667f89da25c731f9fb340651cd531a47a8e6e49703461550fb038d277a275551ec85ce6349b66b0ca46b5053dcc5ed247ecff17a11e9ea46374be90007e1dc5d56cd95c965ec6284ca5cd7b706f62c2f182da9fe386c2b03fb7688db6e497f50dc68af79dbd99d94a22be73dd189fc0eb7143fd0b6f3a63c6c573cee6e2ddba6f9c8e5777d8c585f927f423e16956556cd2dd93a8c14e763c6e4afa9124407d5a9031a3bbe3ff634c66a05fe537fec2b2d7e50cde36aec93d19fb984fc62fef2b0fdd579c637750f2bcb3f5e5dfd7496f1d2c59ffe16fffeb7cbe4cf9e65bcfcaf9b658c3f7d8ce2abd67767193f7cfcf4f143f26fcc325e7d6a5d5dc53f9c657c7f6d7bd2dacd2ce3a7ff4c32fe6792f1bb938c6f04f638c568544161500815d5905c717673f2041bb30e79652d6a9eeeb1bbfa86d5942f461472834f344fdd790adf5a6dcbeecb521924b58e7706cc485cba58bbe853df54c5c8ec2824dfdf70eacfcf9da8f15293379871987ddd7788a543dfb7e4f6bea61dd4a4c24d2ea9d0b8703e018dfcbb81a4ca319769b6e48ed33a795286308ea7c430520586f4f4e9d44263827555b049e614d02b87963d94a987302530e730d6f984a774b4df730831e6fd09bd53c7e044dc0e3299f4fe39de5168ebbce2542d99274ee9e58bbee636a2363fa6f4c51cedfaba42368fc6ef27aceea1288caefb2e52c35e9e747ab9b27a57672e576d1b0777324cc3f0b407857d15bacc1785794d332d2126dd2735914cdd3a0e63385557f2140a4f0f5008a2d82d769c7e29298cecbb68cb664dc39cc23f7203295cecb83c1999ebbad4d02a74cce1bdc388cfe5e0d181b85c568329dcee38050b39872ac8d78239b9969c7e8348915bc9530b9a53eb716a223595908e5c9582c27d32bf6d76e1ae79ea4acb34ec070e53b32a95d4711cc2a85ce592a28c0ba88a91b8858935e48eb12b1f42941c5357e1310d5ae1d0527f5632dda0e5bd4a47ee43b4e973a8996399790a614a309ce629acc806854334f69201bb02be4c411976b15c2e7d9b7953501829ee52598469b192c23c9e4a6577a30499de2c5296b588644cf7358da5384dc95de3a9e97cd13711a76b25f59fb7c46da7d088dc9088dce7b467a17547e126a7ee29f493a971093fa3049b141fa7f2a050e0eb944229769d2984a1b195efa9bf7aac0f2a711b29bcc43cefdb9ca7db6f39f4f64b9627cd32520df8b79e53a9e4560717294c93710aaba05040c6057ca2b0995c2b25328a298f5960d732b8bde9c8e59ffa2e571c868e759db9784eeeefa10f10cb94dd6d3fe1d08042626d53ea136a37beb6867d4a615ce66be5f2fdc4f88adcbfd28009a9b91224354afdae0aab6b76915d8ea959d43aa48a6de65b0a6323538655c54b02248ca0f0798b32c6944cbfe35289eb3893d05952ffd6403902cfe17a4f23f5edb6c0bcec915eb3390e1c70985c923c4a3aab35e1e9075d6786a733b040925fdf9ab89cc2edc9c8e514e27feadb0a799add4135086145416347571c8e29cc9723884a99f60ad379216d9263a4ac59f09208c72ebee2251756d3f805385d66d125fd46b2aaa1a0fbdc5298ee72d2ff950d325cb27e890b000e2139a59e5128413ad304f75bc3de22e9ce1c4b17b5380c307e9f92ec6b9cb0ada29080ec8ae510d6a4a8ccc8b1fee0d41ae9431e5fe0ab8ee330a2ba11fb510c2cd621e51d53488d9cfe8a4db009caf114b8dfa3c101c9d680fb9ad3c88af4fbc879853c7d1c290a4d060614c838981426e7699f1bcba9e9a2209b6275ad4c34936be515eb6ad8d13dd3b68b8b6198fa6c6b4027534b8532d03a4cc7f2f468550e6c484ff233d098421419f5c90021263d7963754d63df59c859af81e7b1d7b63029d86e70ca179ba9784e9d894e8a0b9ed28e788906f900b73cc556711f298d0558a550c356917cb978d677a0ba16ca82f42fdb8feb7a80d2fece460aa8fd741c526c39def2b85dd41986653c406d21ba859e87dacdd133d0bb1909c172f03cfd4f3e442953a47b9e8ab65e158e9794606a798a85d3c74daa134837d198a17ea2f145fe4708f54a04ddb71e6f39a45fd43d83451b695bec6a4e76d1c59c1601c7e9e1bc6fa154611ab9033e09a9f862ccf2d484f63e46a0fbe7bc04a8cb361a24058b11b7b7e5a9f56aaec0e3ad8bf6bc4483a709aa52f152079687d60ddb351aa36c5f54c9363ea6d07892ba9cedc4f0908e9174d650faa6205918182c7b9697012940b181297018cdcb614816879afc335f143a6e52bdca82e7e9400a43119ab417cc87996f8d34902f15a68b3825cf6d95028d4759ea40ba98e482d3db2e3629e69ffa10b5e8de6d7e3e5ed622cb67ace225633c05a0d95e1593ccc7239eba25fdc94b3bd45096fbf0929e02a2122da7965249c7e61842e3439a5842727a5fafda746de393d4e97a6238b5483e278fa94247b0d0bcf42456645fc65aa609c3b293522f4ea692abb41857759a55e54873bb56dd5e5ed078762ee77e29c8cfc3eb93a549b27c2437c8e9c534e806cbcb7ebc6a738aaec22ca4e953b2fd9cee8ad42d4fdd4495cbaa8972e2cf299e6aab785985c3aca535067b571523f10d64899089abd279d64da5c166dc19c5a954f6bdcb91f113257e25e95edf8728e6d4910549214394535970fa42524be87c8b744197fadfb0ed8f95c86a42be15d5a3f1aa1dfb99c950964ecc95f315eb68f135960afc7ea47909999a6464bf49467c61e9fe32cdc6d30787a9d490826ecb928bd6887cec5e6e5207ecb30f5052c2392f4dabcad46a7a96566eb0a0b82467dd2969501a7c163c0e9ae96f07caf232445e4e95a0abd89f502e476d73b633617ab2a06be4720db65365f3bc4615291efc5dd2af71c13e9b42cb53ec301f90fe71ecafc62327d3d0ecb3481a772ecb5e54f0cf282e50263da6f0f7b2d40e0d5818fca9e80117fc25f41f9b1fe77c9a4a4db2e7f74f49eb67c99e3876f1ef7f8b5a7f8bde0308fc6f267baefecf23085c7e8893e8df4010f8d8fa78757519fd1041e0fdb5ed492bff6729f97fb23cbf90e56944f498dec99e0260ca2b03a857b35bac674fb02e2e275f8b57df0f00efd5ddd86ca6e3562524a5b89f8fabd7895dac664fb89c74f6f5fd13acfa81fcc47592e5244b1fef6ecbaad765807c065c6682fef187c5dd6df17a771baedd618208064a6908cdb2d564396392c27d757f200498bddc5c3524eb49a8df8a1eba7a95ad8ecf77047aa4fbe1ebe449fd31b1cb06aca7be5fa5f1bcbd5e4c568397bb00420af40eaa8ce85deeedf2e4be7ed300f84e6e8bed74dc5aded36f0548b6ffd97a26052e2ee5fd6f18f0ad7a99d800d27779fa6eadddfcb65cf7a5ad6a26447efcf4a597efbfdc8df5a22154f8797b053287db50af1d2dc671d49f3c559bc9f8004cbfba794c024178efcb5db8f64df8cdcdca7c9867b3fe49df1ddac28e5bf4ae4f77e3b2ea1fc071ab975917a3e1238d9303a8a09d8c95872bf3ca847f9d497ddf4106faecdb5d435852cd9ee2fafe496de8b70c36adeba46f99007a256d35dbf4b225f75d3f903f37cfcb0068dd01ddfbebb4a9731bd5a7edc62412af696863ea5ff3a5d7512fbd8e7a9d3da95618df2fb3cbc955b8de6264d3fb5b5dff687c71fdff2eedc5c41a57cdb30aa99ed4ad92373299cfc724cf3f1b634c7c722e934fc7e7a53157bc7ef8d290addf5c527d7de8af79771915bb9aded3cb731cdaa0b85fd13b4e967797b07e389008926ce88fbdcee4eb417e9165dacd59f744eb93f15ddf5c37843cf87a3fae5eeec6bb8f0d2873b68aea9ba7e4a59fcdb98d8ad7dda2913921e9de7a6a83ef8e2d21f018dc5f2adf530c76fa7a77c960f88b66fc4cc7ade8fe355dde3fa6cbd9aa583ee89a81c3e6a7042dab5f97cfb3df3dc6ff3d74dbd569db143fd1710c54f612c83fbb0c841cc0467b39139cfc7e2038bd32aff37119dd5ff5d6fddb03f1d17a7a3b7809044ce9fd389077d9ebcb816d481e26cbf9781f1d09b604142c6b08f43acb7af6da3a8ee973bbb33891117fbc16933108e9e7eeec3a62db1a306f7d768ec7d709e0f8f93b9cf5f5114cfc0014dfa9be1e097bbe77bef575b29a1d01fc9920f3788fe9b855cf85bcffec38138fd033cb73320930322829db715fbcfa0638f87932c6ddec3565d0b8e92b13b6c7f74f474055be1613b2e3d7f3672b1a22b1b03fa9ef9f0c3cdca6553f9b337924dd87da7b7a89d5ac3b5830e8dfe5460813c747128e37849202b2bb6080f997e9ad7e373e664fea65d249b60240baa81b106fbc5c56f767e40f418ec67ad3eb96d57d07ff983308ba5edc5ff516774ffb78621928f9ebbca35ee7d96ed15cfbbe21130ef77cdb8fa7f79c77960c04397b6c48cc4e083c3bea6baf53540c0c7a99bcf4b24f8bfb4ef2757e7d3e5e1b4247d219c5eb01d8f9968e4fc7ad5500c5fc869cf48e7db01299d0e358404199f4b464d2df400244f5fb6ffa91811a4f806199a0edfea98a02e18290a79c3fefcbe4769e0919eab1fda193ece6e3fd7b42a4b3fbedbf4c82ad797bbfe9586da6b775d5ebe076d6352d06c93c9747063da5b1cd00e1d78150bb214bba5c56427246f76ec5f7e382fbe5ed980ea0ad0d61c8f29e4960b1d18f42d2de2dbfdc8ff1cbfd1370bf89dea2eb6f6fa7e30fdf1893bf2c737fba7cfd5896ae85fc362fd79371f9dc80ab36e49fa740f5e1d9593e1850b27d7d0efc2de78524f28d4e3c3cf72a109a92ac6473bad6ea1cb0fcec5e8fa13f9391bdde9d8172bf953b21e87cfb2c677223f23c3b07eb5e34e4bff335c9e0dda5dadc77d0df3050e37ed3eb94ebbb716b35b1e9b2e9875e97c1f0ab5e977ccc435f096956a7da2193412deb9bceba7e382548ced4994c8d2fabf9f8b29edf1cfbbb7e0b68df804537efdb90224d31be9f5607522426bb990a1929138f33d1941091d7f71d58f4994037e1f6bcb93c102f913d5ece3b8b53507f26573802dcd2bd378be25240738bafeb45717904ee2e5e77ef80a3dffcad449e661f0f7a89f5f22ffc8e818f8fbf7bf7ee7cfe17aec36dc4d769cd3ab079078cfde6afb8649f2610c1ce0e3ee50178f5c4fe07e2a8fa16b7ff3c258e3a8c7f7bdd1023d5b36f934f9d9282ad427fac68ac8b5c564fbd4e0009efce97b3d7f4708f1b06133eb72777340628a6a0e36732fc2b322acfd43feb27012a2f2e596fd073fd448e03818280427fabaee8a6e3383f21733d05e9e7be0ae0d1f397c92d920fb22984d8c9cd2fabe8ee72d1102bd793c7f450e76d3bbdebefeefe53180b42507efebedfbbfe975937fd32bbac989c25102608a9de77ef770ab2fb16dcfb748cbdd75f9fdfea4db68b07bffa709dfeb1ef42bc326b88ac59ee2732ee9ea6b7c557f25fe8dcfd23c9f76cd100b507ff219e3db5bede2ccec9c2be072e7fb06f67babdbc9ade9a3fa68ac180d7c7673be9e346964f6da5e82f3719c3095958f08f6e070bd6f18b73d0e1834dbf35d1641cefc887b97f4d0fa0ee0160fda5a78a8afb9049a2d4617c891c34c4d36ac3317dd7077f2c7999d80f4c1c790e12bf64ffe2f0ee417e83af2ef6f884f0fe44979fd7b1295fe79d2deb24ab266e7f78bd5e0500f5d5f091f5c21ff71df53a01be06c561dfd3ab8be252ea0c1fe53ebd6e74d4efddd01e8f42a2f4c6f63219d23781a1cf629837b6330ffa4680edc5b714dbc3c45d4c46d910e205db2460dbd5cb44b11cae45475707320ab27d0772667bbd0bc74f4929eb8694726a032965c73ff75fab13e28e5322a2355f67f89afeb3d7de2d4ab7594c1f37cf6fc6f1b9ef71aedb4ff474b0afd9dd53ef176c7ad326fdd7cd3bf96ecad91386b1287503617843846627b7effc74d1f5ddf2eaeef6fa9cbc9e7da286e00516f757662dc4698144bd7b2ea7f3a76443f7bcbb2d5aa763bbdf9d7cb9efe20bc53e0ff60c30fcbd4e7ae72320f52d5dff0763354dfa6fda21b47d204a3bfa3d6f63fe6fd8edf376a2df2f4ec96c7ec5a649fb911d169ffc680ba681a4fe544eded88d77b6ed576292d25d573df6cde4fa37d9753cc8e0107b36f77c1bd33e9cdf43c64efbf84ea7beb463e2cbd62b8d97fbcb88f4eda75efbfaa5b4fefdefafe859666f7ce36279ff64bedc8ff7ad5e477d9d5d562bd2a77796f4e3915433101c707c70f39a8aef7e12bb703e226f80ffdfcafa7279ff341762b563ecf7b67f6f2663dc91adbca358e5e0df924f6ed677b79af4f725c5a49331bef43af37afe54bdf43ab9f8d1fa94ac56ece331360eef887c9cda48e2778a350fef973c4e9f3847104d3b2a62e24826010bbfb5e9cb7c1c2dfaedebede00f927b6e8f25c9f4fd55e1a7e316c7be4cde2636ee75ca71c3895d0c3eaee8ca16e91ebef7cd533dbf59059fe8c44f14fd1b2fa7974d4c3da967ab323ae4333a4dfc08c7feba6e08fa9b366f6498ebbddcaf704befdfb4fd816838c416212e6ec6138deb6d2f5bec6e5c2feadba3de395e3fbdbf6d7253a2bbffb8bfdc7f39936f1ad77f6c4efa5bec8abc0bc5a4f1576ec74ef2fa735b905f0d5fd3baffbaa91f820c732c2531efaab90ed50b79c2affdb66f482429fedddf64d71f1aa243f2f50627e46bd237c7673fc8261f977c47935b39f441275fdc3fe1d5c958398f05baf3358fb16cf9c794c6cd25fa3382d8b3bef1bff00c217638e9b366bcdecb3c5eabc93b1dfb69273699c76cab9a9ff4f11b32db739b7725cf46715918b7a7f6b221950e249318bd194732168e7ee7f1fd832f14ae49b255ffc963f254b7b8f9a5da35d7239ff2304e4e082fef9ff0c33c4b97934e2071791fcf1dc6f61b1b731cf36fe3a586543db4d34f7315c1dfbab3e7ef7b47315d87fcbb39f7eb208b5ecbebe637e597fbd5a49aadd84f5bce33d255f1a3f8059fe85a7fdc533cdda95e84903161bd59de2ee2a0a758af95995f0fb22812bbcdb6a1f12797f78fe997bba7bababbe2d853ec42070eb6eb24e7b63cf5178fba51ecc4dbb17c4e72d68a391f9ec7a4ff5e264ffb2ff757bd6fd9b87ff6dabdb87faaf33b9ef47d49b134f967f4be7db9de975e1bea537b34e55c2e9fab7aedc6ef3dbcebefd4de37595adf64e93fdfc524a7a49624f36dfc503679d28cf38d47b992b924eadfb39ce91bfb1eeac7127b65875ca19eb21d7caf5b4e7c95f33c2ef92154379779d17ee67fff6e4e5762aa47ce7db50b7f984bfd655fdc2f1eaedee66e22ea8397c9ed6ca165ae71ddbf8d4e6d433cbb448fa773b4f67bb61f25ae38b4e1e11a4f4cbaa964dee644c681e5ba2beb0a38c76f65ceb8d749e2b9f48dc879a7fa3abf6e6cd639a1698823ea431c61cfe3883312a1c7864468dd10d8ff93edd2595e60c2737f28445f479bc8ef55de87b1fd74df49becc1e97327e1b7f8575fdfacdbc566b3319b756f3cea20e6d77182707590c735a935521b688c966932f0d01e4d1061f6c4868a7eaeb69bc7cf465647cdd64e94eeea55e42dc711a5f5d4dc56eade7b76525fd63de10a19fb68527fbbd38cfdf9cf7edd17f936b8da35fd00b67ed4b7137be4caeccba8fd1e9b39e910d9e3d6fb04b93a724be7f32b74cee63df126dcaf3916e957d19ab615c9de4a2be99dba4f7fafd47b6eed4173dd836be863ecf87ca737c3c7dd69beb73b2c103897dbb967944fb26a72ab9b28fc5316f7c96ebfbfc2ecf732883eea95ea6b7e6643ee8d39783aea298e238f716e25b21cd0b6b399af917ea5791f5f0fc67f76072d0a3ec9ce616424c23f77d43b07cb49bc953afc3249b72ffdbc1b95e39f413d9e5efdca7b1f527f6a02169e6f1acd22ff797bbe3dc90e8aaddfd65b269d607bccf2b15cbd925d4bd2eff56088b2f93cdcd53f9e5fea95cceb325cf418767adbf3187c2f3d07d7bbc0fcb79d6fae3feb2f5c7ddad5e4fba483e038fedc65e9ec5cc6773d53efc9ec96be9995eee2e9397bb4b15f5bbdf5a0be0bf952b0bf3da3fb8d69bf54ddf7a2e5eeff2faa36bb4be4e56f3f3e73897fd437bbec9b7e49371114f2ee74c9439ebe2ebac937c0d73cfbbd953f5f28d38f65fcc4d31c1f817f6e93bc9aaff0d3d305087f99de75ed65b7cceaedf128b9efdbdcf5b6df6073f5c080a3fd175cec91ccf74ebfb5cd6d7e210770af9e2ee1d89ea1b5df1fe3de2ffedf7889af738cb1775a3fee7c3baa6435cb1bd1b53ccdffa32e9e03f67af69b3be6631bd6c05827bb26113c98fdf2eebd995f99a3dc55f265da438a37ae8a65f662b59b3632ed576e2367fee32ead5e6f3ee57bfa07f53b759547d75f529fe659cee0f7ffa17f41ffee42fe80f8baa3f5cfdfee153eb63fcfb0f71ba3ffe3b5fd0fffef1f78fd18fbfa07f7f6d7bd2dafff982fe3f6bab7fbeb6fa8dc09e81745b179b918344dbbc1a58c8f9ab52ed55a96dda73b15e80003342e6d5406379a3e1c302f24a9b0078c35f465fd7a9f3157fa58238a06308bb3a851c9dcd2bfaad85087b02fac9209529c4e5713f8a03109f42cc3f2d0c1623ccabb6ec270a7c0216060ca46d609f3a069956d9d8a601d8bad7ecf711f8cb7006bfeee5f3d4ec0edb56330823035e2e6c54b503686238bf2f74b4e52f5bf9abd02c755ad002b83ec478563f7cf1cd5fa8ebaa1809f076a118dc3b6c3be5172ec72e03087b650db4521bf1973e290313c97b38cc950d40e10236265fb665b8ab33a3ca32807507606e35345838f9c26ea2e0316dbb1c53f9f2e6706c6031e53a109b42c35ebe0497afbe06e1bdf80b7bfecaef356dcb17f8720ce40bcbe62bfc1be7790c587a470bb305e615c8769cb9782080df79f3559400f54ab96cbe4a3cbdc780bf6eb269aa632340d07e3f72dea85e9e0ce9f9be751e5fd3be007cf3f6c4c5687b744fd0722caa477a2160e13d354f8d9f3048b9535842be2d1c8385ef9baf5733ba877c3d578c0424b0180500f191ae060210aefc42470c22cb80b9ee310d5f9672fb5b8416836cf572d50e007319c2f6267cc9882edfe7e3e3b6c22c1d5b289afd201b0c88b6b07932902f055bddc3369630d6f2c529d543c55f6c2b4b6dce480b4b06c1d3912a0dec16242b0cf00df302fc4ebefc8ff6fcb5af86f8d6e68965e06f2cb441bd7088432d6075e19829f90b58fe1a54beec84281ef1179a9ee4b51cb95c81b1a97571a9748539eceab6c582c660dff0976602baeda02c02e0309d1b07b95d406c941670cbb6e3f72f0716cd087ce5e42bd1664cab12d4a0afa33df2d7be7e825a40b1db0cd62dfdd87691a065e828917b45c9908fc7a975d13e3551abd03aec3392c03eec17c3d0ce6d17cfbb168b41e64b06f50206842fd1e9ba8d1097f2e57589ceb704e83c46c7fb950060db88ea9b1473580430de9c81bcc2b601d43d657a20c0b4bccfe05a9112606c6f4e9f2d17140053b89cf4802918bc0a0a055551f057ddf40cd15e698f162a4ffb96c66af34532ec0288b6251dc6fd3965a0ecd7d4a1e2afe87317cdbb1660d100af9a3ce99aa85267fbb13f3f1f5727bfdfb70d8f4b01dad628b26af284da2b455514e36baa1797f2ccc9d04638e9a934e87a33422806c6a63983371ef7b561b92a1ad0c61c2abfb024c7d7758edea499c714b3b41cdb94d1250c946d9bfbbe813a0d20f8f22c073b8264e718d8de60497d0f99477415b6ed75ddd130eb1bafba869143268a649e01b01970984a0190e46dc588071d0371eaa8bf63854c742040d7ca01832d762cccbb0df83503f0911df1741f017d6ece917e96e3c908b3b463909e7321fb39ebb40ed9bf00d6d7110072968585e8001eef7c9cbf1aaf0e768a8fb16d51033a9e9b0816fc353dcbf3044d3e57a7fb98aba1c6a2c8fc6c0b3183c875c9bef5f2f0ec919af454a9c6d775d740abc05c0d6057772da821668d7e67ddb3e0f68f2aebb274e41e05088ef7d56061a264686c7a6b4140ad351a6774dd75915e985c950de0b5215fa3013cf4f3917b4d839c3210b67651a2691b3c7635929d9c931c292949e6e459118b91805aeb85f5491600aed99e586a9f8c910c14035b7bd36c33ea8cd575cf45f1e8389696cd17b03d17253701b1077a79d913b95d22b5b3f124db4b64b2033feb5b68b50d03ab5743ccd1ea284101b266a492858b261ddab6b05b68af0abcaefb1aaa41b36d603f188bac20ef6371d3cb938e59d47d0bf394ec1583474767db34ee466e57f7057cbd4613cd1d97f1a06f2326efb8b1d0ca346c31f3e4ff6c05d548b62799dfded85d4d3e40a63d8fab0362448f518904505f902462ea6bad05c07fe0a27d810c2e9d8c495ec2b82cc93ef6f258f5f272e480bf6226b97780584025f2e040395468b500ca96e43b51bb6a9b02f8a4004162eac8d7ca65e1a256ca20d490f0b3891c30aa81ede5dcdf8ec18bfddda2211170881de34d81f25e430102cdfbceab5b8d65cfea7aa8b12e32df5274fdb1f77dc73e546b44f52dc4dae689a008e97a6803e94ce65bce54b3858538350ce4de429357d426c331fde63a94f9a7beabaa928119410dc9ff3edf57edcc33d181462cdd58d7e4abdbf06c236070f9a8efe2a2cbc0c18079b0230c46ccc09eb962541ed8d1f916305030989116d40ede169060e8438e5d237d740017b639cbaa33b8e8935dd4112c5c7447725930183096aea718d988b71b5060884b6d61d6877c9f8ea1188d191498fc4ab4c6a664939c80faee554f09320c3d8f8b5a13fe0dd58f928e80fa3242d2c8f157f833de862c6d5b8502361c255a474b4682823cb101e897018db93c929604805f5868d867060be067c7a21d9e1b6cce5fbe6764b340e150436bc2e80c713911705ed50920bd1d01e98d188037100c580d8c8eb3d03e19f2d7f1e203d0fd0535c72b2d60c185d2b1211fc31a416b6279c0487c37f055c9baaa2a0a07711140731dd97946500101e984d74929e80c4cd0e0983023666434a7c9bf60e05bf2af19b187fa857c2d061665a0df452d7d1be19da363b89c30ea194e94c9528a37c68262b0a4182327fb2d6d51f6820d64c05a8a6f2c23af540c846bd1642eaf4a01bcad80e20fd6e5794ea565d9115403f11791519de8b73746d082069a01f62bc500b40c141c93ee73ce0bf82444ad1146051346c9fb25e46f30c149d8a6e7d40c681da705b59b2030959a11c0047476e0a2a46b6c5aba88818b1d1ccf31b2532fdf2bd2971093efb20920b0d70b01a9a5ed54d0c55491824a99f4498060af17e4bf6a3e57055f674eb2ea0480b4441d6d1d23472963c736bd7591f40932d07175a37500808d04f4954907402f58a7ea1ae87de8d9c70c029b93ed6864182ca3b9898e6dfccd06a495ae81c8e0acc020b0684837808bd38111b4a411c42583c7ba78ae5cbe64920a7a47466f62b4aaa2197780d05b04020b4028c44e570621a2f1646e35a3eacd19b14bca6d41cf8fd1a745e317b8a8453280705d0b40f3419fa08668cba0c88885a277d1ba46f048ef98f6543ad0e8fb427e42baac4ab36a92523b685d8f0db4105ed3320052530c660351d2d84232a0580805316aece222058f877c087a8e99c62eae1462af8f150ed0e77daa6f77f52dfdb6419dc20821c4ffb734e6c9970da425b7c024648cd0571af8d01f03b2afde53658feb540306466d9eff4f4defbeace60fcfb3f59787e75f4df27ef31707b0d40f1f3efeff0896dafaaf4bf5c6d1d58716a764ff64b0d424f9fd43fce1df034be5d6fe4faaf73fa9de9fa77abf29b6c784afcd1386f4d1b06fbb683972ccd227ec3d928c6563ca0e978d5a9c5033d0ca83b3ec18199c8268afd8a03b90441527c7160c4945015519e0d9b40d90550c915631bc55476025c9212b5de68d15b8307226f026f35b65760c27088119ad6d63327abbbe8eb18d1067fc2c3e190568cc11e63838062bf5911d22beee3b8119cbc057ca305abf4286f18c1442a4ba0cd916b18277064a6bd0701001119602118577e7e8fe0c53d70bc8f3ec24092460801a13a3e06c5459765448c97399dc085afec17129846920e960ce6d4e4e00c342429c0ad4549c2a6668885325e8f04754fc0037c9705001f97e1060d4186e6d2cd06acc2c8079353068c009eafd901c5b9b2792d4ab52a5c5f16ed80902c45ba14c5ca82601c0f5a0ec31241e05aec2c0c04e19051f127c2793060ad4c07ee4aa0105fc14c88f981528c7bbcc9768fcc40953043b00e490b7ad301771d068b10e89f1e44e12ade7019e81669ffb4799ebba71aa06ae49185718e0c368acb524d02667c46b0aee6ea45d4d601be000b641fe27c7ae71de1a347f6155890d9a6b76cc068115e19602612a2dc3fb15e43449f04d65f4a98f3943908166478b1ce96228d070454a0e9a01450e2704547b6195c87376d60ca0236730ab0e894346597782beaf18699fa160759ffb3520ebd33b21c3c99a11f03b53fd0499f94702457284c0d2b8e444c7291bd184c6d64d60e1b2c83240723163e8c2c03c410178c68157c3f0c1811a39f0342e0b8675e4246a559606e6a51648466aaba124df8a662264c4d097ba0e930e9a7e3714196196be4263612dc3a84ae2c4093ba9cdfc84610219361234e9a4b605359084feac6f7225cc9ad152314431b7c13267e6b6ca50400acc94b2e09202151056136319ba52fa53f45365507b7498335c2320b452726a29c0458679a3ba68c3c4d7586349c1ddc89153cac9a9019574af37ce27c3da8d2db4264ee00dbbcc66e093dc46ec7c96dcbfcc901298082281f86c10f80fac01e49c473894631440cd7b0213dc128650bf4f658226199a9c751fb3e19163cd2cb31ce4a90eeb4481e543d2c5010e31b0c530bca641c000bfc9ac725c9a45ad110bcd01a10ac9972aa5e04733e4a4307090bcbb10d0b39e66468c9c19388515b04a1bdd34d058891d69a06aabb4000ef299a9425b66254985d520af1c33c89db21dc8a4d9adc0300b9c7008526432a942c06672c7570c1fac3df403c3d14098fa18c29a198a80ec644cd76008bd8e857d79922c653606d67b21d99df97dc19318310628671eef021f497632575d66798cd06a861a0eb6c06f8724ffc27ccab094ac87b557ccc2272cab92c40386d0e47653e0ab91b93e658682238c7495a63ada32c4b48b5ac29e565503299182c02132235fab00c54c9cf40cccf6e33cf5a730d1381a03b0a392745b8930233f606c399197808b8b4c26360e508125f90c0e924218d1aa3b8149ac067647b622d8746076b6524b32bda0bee0fa12e80e2c2a642631618363e853817e2f47c84cad1314084ebc831cad0d2c463a4a6e1b66c500f92e70cb7e5f883cc8e4234f2a303ca43f9924a0e0396606a5ccf3643233226621996fbd9a8e99695565b0ab731723c3c837497ed2ad8e2716c98e9556265ef72379ee220566611156d9c0d63aa0ba21013b14784bfeed680c89300733fb5b494138db589763d7f033d568198eba1e8d313072903f94b758deac4f7a6e51f70dce9553cc3a4832d773b1518ec775310c496d907b2e0b132dfa24777a21ec8e0d135fe6974ac3811998cf09ec2a4fec75c8a687c99b0e78d14907664d8634dd33d392b07e2ea8bd026368218ca3394359770c94a9e3f200519cbacaa4107b616acd770c5fc90cb0ccc8590c985dcb175633b46bcaf723b9d1a4b38fecc71933e6302baed2ccfecc13a30d24b6247cc896335bae244b99150a982d5be04a49b6031327d9383566995d224fbe7a81c30d4c4ab9857dee76cc2e9cc964ea364c3ec3114695f66333918983ebbe86b23081e957f324e73e85ebc30421438832ec6e9e30c4ae40ce565df24f854dbcec2114994c2273bb3493dcf998db6059c8628a930504d0720caf2d70fbbc58c3f9aa0c2cc83cf96a854529b358aab142eaa32c4021178699a30b65e0ae2f8c8a0decad712793852af3c9c0b2ff1c128ac2e0d80d49b8b68662d4c8b78bebc2e8ffe7cf5df3f68b99906fa53f7e61a5db7f5dfae3e37f2157ccc78f1faf5a7f7efae3f738f974f531f937d31fff59e9f69ff4c7afa53fbebdc86d622d9469e6555798c819463d757945be6bea54ea3266f2ac47bc0f05ba5cd8a079a18aafda644b9c9f2b5ee846f6c7b37da56b6a8e85bdb24ed729e6fb94295176758a48719292457548311edba1b0686c2fbe9e2c001bca82315ec466655b07e873b4e3a3adca98a2801779b1ce263f4459a8c3a235ce356406f6c00bbf84393a335894c8ccff45802817bba90f30d649136f0fc81776519c895d12da1c8acd8452e5e0bb32cb64d0edd60ac373061592cdce64814241717eeaf24fe46377795ff24bc8f11ed305307c7ceabcca35dbdeebbe8e14f9fa644fda62efd8bf68530c487eb726bf1b5a2381dce77df2d118c65b4738c45c21f95286278e7a6ca36d28a9ff785113165df1bf31d832ce1534368dcaa1e6f349db457b664bd411d974a64329c097b26848f22b6d9727a93ddaf511538744cc4adb76515c68a17c6962e5b68b5a6d83c1b7f406294ee4c550518bc64b5ba8191286390795ca42a9d84003f10de2d3f2a49c66df83d9fbd98fd27151040a8136c55cb0e3f296da3bb07eb6c79e17bde41a5aed66a1d291b95f81e5054aec5f700e8819f941952e221fd3708ecfe4d5d0c5658accc45fb990c7c85d24ac7c264fbac2b43f098cfb7359c8e3191a3d075fd158cdc91fcfa80da24ddfa8790ae4138262264903f30928dda7f840cbe4f73759f18f8cf714bf4d38463122e31d913361b937f2be1d0b487dd311ba99407b2114401d9e38f793c2e5d1099b3dc71603613a9e0cc65ad8e599415e18f247202c7f1d612d9fd8d0b64756f85d5830c3941c681d28f2b5bb56586765014dc3fc4e3e90c43c0debbbb0be1e72a6736b00dbe684d5bd61cfa598322c74a1ed5bbb3bf8e64c81c18b5af2aaede21464e10af975fb740cca71ce276746f5815395c0e1f36297c0ca1e69c91bee2456386362af4ac56ca59a63b246869bd842f2c2c013dcc29a49f1515ea599af2cc7260d4bbae8c73e339a0b1b3a425ec9b3466a48be25536144082c93d735c3ffdba8ca25073ca3ed5babdf339dbb5c659967ff79a0912736071ae713613be7c97b59c0c2cce4a93540ba028714271a0fa76ce4a58bd109a58a2c8276928f180865c0be6818ce5d54f67a794b18be73f69d072e9ad136f9ec43ce37449bbe0389a535c40ccbcff40f0ded85673a1a8a8bdbc236ceace1a9b0e1937d2a5116be1c19c4755c1c287128ce76148b914c78de1f06ea2c38e456f3c02c4e71bde4f44ba14188f93ab80bf44e4c1bc0b42e4d0e51e27fc9059ce60b80698578a12ec9c491e5dbf93a651a04ae97482ea112aa01a1b16a16bc0c0e0cdf1c27f8643086588e734e3cef3bd67fa4d703b37795a624bb9087edb76cdc34bec2a211a64560266e4df599f19927d38559bb8b4c6b92f65c84432b8b5f988e48f29a69218b1552b2b15a185f8fecd74c7fc54cd415d7e1be1216ecc07e5d82b06c8b7de15255fc6e87c52f213fd6cc67582c85c13ae4c8488ec3e29701e7d1f83d84c53ad81a1df4ed19ab3542ac6dc4ef46b245bf9d8057a505dd07b2cef0a10f5e65cc98ceb9dcad304f0b7bf4d8457b0cb17d60e72d46dad3f5a8a4672ed8af009f94cc021dc931ea5fd6534c2316b6bdee332d46453e97507b618e345e99ad57a311c6dca80c0b4d98de6dc08b5a806964e87d790e07e4999c66fa21fe0d6a9f1f167b41c471aa63da9bb0a0c5481ebfa761ab204ed5715e87c64619f2a7acd79ce59c7ac9f93f8802f3725c39b6bdf2ec03a9bf47664e46ec9ac058dcb024bb785e32dd9bc4d18ef4bb50ec94236436ead026712aaccf712a146f715a984035689986a6d4815a4ef3f39c30258f75ed00310561234e4d5ef16220cc55980f0a3457c2921d985b69bc4a095edd1e588d352f6a296c14988de51eb2a00578a10f72ae2d47eb8e4cc5544a6e3a5799cc5750899055261526631a778674f11973b1cb91fa52cb9c48ace5b7cb8615f9c854acb0d48b3a3cbb29848198e74a4636ae460d9330cf8130ed8c097317467270952c5a94452c8a1753f3365ef75115288b5430e3052a58b08d419e072d80c7caa246e72ba1bfaa26a98e91da78ac3977cfb977ceb9a37c3c70ab99b157391b587f215a16cecf21b0fe36797ca1f0120aab5b03b4cd3efdadcd55a024abb4d0afe9fea18da384628f89c622ff597e44e2df875f0c80df46beff4a44f64411e4b722e3b7775cac2fee1fbf719d103effec01436cfdf7dffefadb3f0ec1b504aee7b1f586f6fec7fca17e58cd1f56b3d7bffd8f93d759acd78b75553d3c5c2cd6fff361b5785c3dfcf5717d713fdd3c9cc6e47fff6d3adb3eae57cfeb97edc3f35f17ebdffe22dc32bc45af2c5bb55f3ccc65f379bd7e92adcd7ae61fb6ffeb714dbbff3809edfffedbe76abaf8ed2fbf7d7edafe7696c13a7b2cf9fd5f1fd7e7759ea6cffe7eba7dd85cd05d9f7f7892fe3fae16174f0f4fe7f5368fcf2ff5e6617551ad17cfdcfc8feb8bc7f5cbf6b1e217db2e2f9ea72b0adc570fdb8be5765b87cd9767aab0666a9dedf36cbdfa225b8fab051f7b5dcd7efbcb6f9c1bfb4793bef8fb6ff72f9ff935ee5fb7dc89b3f553fdfcb0d95c7caea6db87d3038baf8f35efafb6d3c7d5c3f345f5b8d986030f9c929b3dbfd6dbf561e3622a57949dd963bde43609fbf3d393f3cdf4b8f3303bdf9d5fb65a71f2eec0c5e36afbf0bc9a56170ff3ddf479be795badaa1eeb2d2785c291e5d3f464eff0736aced0bc6f4f6d5eeeb7d5c3f1c4d3bc75dc09ddd0eccd3e9cec9cbec066398dcff62e5b1fcff65bf1e5c9fe9b5b6eab9376dab7a2e47cefa2f68ffbdffef2dbc36ab69e3fae16279b17d3cd2a3edd2719faf8e1ecc8e36afafc7a7a64f9707ab58b3f48624ff66b1eae0fcfcfeb677aacef4bcbfdcbe7cfd36a7dc13aeefb927410f05fa9d328819f57aca724a0bf58f5b55a4fe7bf5477fb3c5d6d4855fc6bb52fea755549dffc2bbfda3ddc8ba2f9d735d1b7eb5cd4d3e7cd5bbdb4583f3f56d5f47b77fb9774dafbce7e5be320594fd37af38b55b753ffb05e5d7cb3eaf2a19efe4997b9f8fc586d1f9e7ff254a2b67f49b53f3cdd3fccff3d23f09d7a9bed7cbdf999b958acabe96af1d7f5f3e2627fb179dd5cbcac58432ca79b65282e66cfb32bd239cbed53158a8bedc3531d74fea1cdc82a4cabc5e9a159fd72bafbf969bb1181381c5a3d6cb7cfd3d9d985d61bd619a78748284ef7dffee4f9e173f530db568fe78fb4795c2daa87cfd5e3627976d7cdeb6636adaa8b87fdc3ec816ddfbb53a1218e23e261b3add6fc76eb3776560e93b56d8ceefde3e2b8b9ddbcb1c54f6456a5b8787aa9b68ff5941b850ffcf365bd7d98d7cf8fabedf49e75fb8ac5ec8d01a74dfed7b4dee160f3c4e11899dbfa79cda6f68de55f6fb8017e0b197c2968643f84fdd0aabcb578d8d7878d8bcdeb6a3ba5f6797e596de575c2d6c54cfca7b07768bfe976fdc4f6f5dd99d070ef8e6f5ed9159101f3032f858ae3e543ff91f7f2b0df9e0ed4b37d516f8d8ff397df5e568fe49e1eb72e5eb69fe38fe7fb9f787733fd4cf5be3cace6ebe78b33096adc97e574b69c5e46bf56ab5e57aff155d4fa496d2e48c67eb55e63d27f54f9e5f9cb43e30afda0ded2cf3fffb8c67b2fe807957ff2c6344ce7ab0dfd3d3d6c36d3c5f72e7726088b1716b49fd6ab9fd7fbd79f54bcbc589257f0835a8ff3d5f407a739d2f8ce7952b3a218bf759647e9e661f6f2fc7071ff387f7c7ef96e6bca802617e0f3faf9e947959a314c17fc957a2bbade3fdecc55fe9d83a2ff458a30844267f3967fff8dce90bdfbc77fa7e9cbfff7ff030000ffff010000ffff30f21ad382070200`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7d5993aac8d6e85ff9c2d75bbd6550775911f741b144dc4a6d478613273a980ad0646806154ef47fbf91cc20a0b5bbcf8d13dfe1a1bb24739164ae5c53e61af6bf7abaf969b9bdb77ff554ddd37cf19b6419fd8b05e4c010ccbe64c98a29188afbcd067e0435d39dde5bafef5896d7372cd9074aefa54719b6e5783f054febbd3d33ce4b8f160ca5f7d63304ddecbdf46696d47bebf55e7a7bc151152ffb806af545bde6edad6579f7b3580b9ea4f5defed1fbd6fbe74b6fe70940e9bd798eaf240f5b45702db3f7d6137d1dc8ff43cdfec7d05d237ae9a5475a731d282e7c5d903cdd321dcbf714e79b6af55e7ac2e739f961ebf10fd1121c19ce2b7e9434c14b7e013f6db34c57315ddf8d1f655f494054c1484034ddf52c27481e2c3701009628268d1041f12f5bf0dde4355b710cdd7575cb4cc6b63521eb3bab8a1cff74141b08c9388e059404d8b12c23fee52a8e9eb6ba4a3460f2604967c5fb5db792474ff05230cf72922f79ba91e2c737e504f4aa88f1cbc9a3e5c8b6209da3b7ff99124a846531f01448099213d89ed5770453ce9f5c4dc086a3c2b32f7ad11e2ba664c9baa9f635e5567c3cc19d7de97d0241edbdf43e0daff7522444d5b2540b0045e9abd66fc90475ab0ae3e80008fd6c09e56e4370cea2e0296edf3eab8ad3da0941e0ac0cc528c3b9bae3dbae62f681a53a1129eb565fb77c4f07d1667b5a8a0853814b3015afaf799e9dfcf41d0866c1f76cc1d3fa9f3a50e00fb8ab8aaadc209c6b39f04dd77324cbbcc4bf745385efb88129251b176d9a0e0918f2ca4cb1e33df13f23ac647b63c01125cbb01dc575fb9f40f09462831aea3180e909baa9387da0bb5e04607acacdcbf62fdf48a1b8e7926e6b8a933fcbc54ed915f2074592b5d253a953c68643745c680040b73d5dca5b3e75db450748dea09de5cfc29321148035fbace44fbae9298e2980be6839baa93676f445516fe9756b3b25cb743dc1f4922da9762ba6e75876d0bfa0df906f480dc0ddbaaa3d6584d7f5f655c9688300bad03682a8ab8625b700489a229d5bfa6547545bbacb3b5fd7ed0a6dfd55daa881b80a8eec7e05acffa92ba06dcd65eabaef2e91db5db701dad76480b3d2b665a6ee7a4adb076280fea72e782d504eeb24aa12ba0e006fef1ea2581b402af29b003ce0b60e00fb5b66200992d632bcacd86e1fca41cb9115e7019c64fb0f20544b5644bf85d023a8063190806882dbc20a9609829a5eddb0414d7359dd969a136554ed7203b7fc92210f0b0f659aad9068f945471a141e8aafb99a80969e4a2456a6a82a0155e9c50305b1e501f70e612580db1029703f7ceadb671d9a18b2e009a2e02a7df70f5079eccb8e7e519c6a6bfaa1827d52345504d7448bcf70341cabb68c06a516dd149ce0b1fd537aae9b48b9237a823693db0e62d9de0388abee94cc333870d491d801e58e4b69b97624cd14c7b11cb7d58613fdcf4f01587d4d71946adfa7e3f67d5f97abedf1e9a0ef28b2ae5ad11ff7574cc33a98be62aabaa97c11b8ffe9c0b3cf975eb1857b7bf4f13b01b004f96b2f25a780afbde43982e942bbfe175febdb16003193fcd2eb5060b9bffa7283b5df30802d38aee2fcfbce0df7945d85c8b8ce106cb71d343e843c03d3570c51919f82bc3bd234c0b99e6cb98f0e3faa050453fd66396affd687679d6c7570cf6a216cc7ba05d50e3770fbbe1989eb4449c23f7dc99122c1aa790648fef43dc5b093634cf63141d44b8fae60169f45dd5524afd412788a00d46a536a2a648d9226489af09aa8bfbcd9ba288ea02a7dc793ac4ba9c7f68b8fe9f90ee8e5097f1a5e72cccb9a544b7024addc929a1cd526b7dca6dc6cc5d10dc5ac0c6895e08c0a564cc5f31c412acdcb7223295e6c82bc5d7a762cb82a47912ca78494ea588ef20914c9ab2eddf14d6825f505cf3274a9ae47521dcbb7eb7a949bee699675aeeb536bc752a5be2b09665d57c27f35ed9e56d76edb8ef5d90782a880ba6e37a81dcd0d5c4900a00f74d3bf15015ce1537174abd4a49b2a503e81ae6aa59dccaf028a4df04ea08adce482a0f4ec296e79b46446ca4d9114f352d795b062d60e870056891223d2816258522e58b1c337e1ca34454858295aa1d5ffacde95e85ed41f0f0b2c35131dd13d4ab435c94ec03ffdf86c9efcf4dcca654bf6bb1f4dc688cd70f8a76ff8c0d36d2162b6a8e10fdff214d97674d31344a0345dd5c05b9be8396592acb130d1bbb6bee04aba5edb039fb0c61ec9320ccb6cec763f2f499fa9787a3a47689bd98ee559f7d74b961b6d7072d1547bdf14b167f1e629fe0189c61320ad26349cffea4bd1a5a00b7449719fbaaa827f72564fe80b5e612937af28ca4bcfb196bebfe8ca7ef57def131d959f5fe3c73ffc180e1221fc115b9417c5942da75fd239c9512116f118f21c946d8100c591e103e8686878f87c162e3d54b40067f490dee83c03fb60be90a064d3edcba66b28ae2ba84d13cea812fe4ff53df719b854d5b701627d0d1ac72d50ba6c0a0dddd06c888fee75bd114db98ae43b4a5fd465dd897d1d8da0913dfb6939461b504a7170c067e0cc78bcab229ce145ed5e71bd82a7025ee5ff0ea56b72bd0ebb6baed84b87affaa3d5bd09db72315d2fe7623d61b9253e86938b4d7b2800e012d6d1810cba92bee22f5a434751e2cca9754191d6da922bcd7dd5fa165f4e92d65171a07fa3f7d643bfa183de9f7ffef9d283e2ec49d7d75bdff645a04b101a7acce05f59f10478f278fb570f7aa77a6fbd04e6a5e7eaa1d27b1b20e3d14bcf80b2e70d4307df07af0374f83d6af93d42c75b0f43b0c16fc8e0370cd9a3c337147d43701e6a3af77719ae325e30b40da0eb4db9f4de4643041bbcf428d3eabda1283a4087e84b8f06ba79eebd0d22ec2abd3774f43ac65f7a075deebd212f3d32f9cbfefebb2dc848f47b2bc3d19097deae30d5293817673e059115fef6fad29b78ba01d7bc53a4de1bfa7d8ca1afafe301f2d2a35dd832c4f1d1eb60800dfe7ce9adcba0283ec286af233c0545fe7ce911eda30d46e3efafdf87c8f0cf971efbfbefbee9bb8adc7bfb07f282bc20ff8cb60efa0d3a6f64e78decbc919d37b2f34676dec8ce1bd979233b6f64e78decbc919d37b2f34676dec8ce1bd979233b6f64e78decbc919d37b2f34676dec8ce1bd979233b6f64e78dfc4ff54626920d7a45cfea17dd56a96bf2cff87aa0f766fa00c0011d18d2950d99bf107def799f675f72dd76bf2704f8ffebf4c432a727f6bfc8e989a2f8ebf7cee9d9393d3ba767e7f4ec9c9e9dd3b3737a764ecfcee9d9393d3ba767e7f4ec9c9e9dd3b3737a764ecfcee9d9393d3ba767e7f4ec9c9e9dd3b3737a764ecfcee9d9393dff6b9d9e916ff2dfe9f8ec47aea2871ed00c2a73830ed1d7d40d3ac04755ffe7e83714f90d1ded31fc0d47de50f4dbe8753cc286f82b56f4847e0ac07de80ac53357289aba42711c43065f738546d36d7085a2a33a5fe8181fa068ea0bfd8ea0e3218221f8bd2fb40a9aadb4d627da08daf9443b9f68e713ed7ca29d4fb4f389763ed1ce27daf9443b9f68e713ed7ca29d4fb4f389763ed1ce27daf9443b9f68e713ed7ca29d4fb4f389763ed1ce27daf9443b9fe87faf4f34775b66ced11ea1d3b668f0178aa0430e1b07fc6efa9dd027aa448e4381ddda223618518b2510c93922b03cf8b85a2a456e6d099fba1c7b1e51e4d21531da89dbe98b488e830f7d72da9e3472fbce93713b7f11cd8d276160c4ef2d959e4dafcaccfd41115343c486c88a9581c0c8963cb354ce18bb7cdc870bcc16116696ba0ed7c977b50c6ebd9f5c97fb08cee3d8a529308311359b44731749e0f33bd4e219600a8bcd889a6daed2424dd6353ff3b03f4011119f8ca8d91a4dfb38633c58b1b4ad18c0e7c3682e014f1e8315b3bc64f325c71a9ca7481e0d7956fabe17b759ea9a18a0706e9f1beb0785019f375e550aa74f22be04399ed1806736aa52c1dbe6b40ee8901a7c5ced31a15a2781191a62303949187d918c4336ce8a59ba3273b87b7fbd7fc7e91090d5f7e5c53110f5bfb0b70bd9e6175beb439f84ebe0108d2390479bc734e4439fdcd6b349f23eaa49866c8b274b5d97f618e2f550c2b74c1e0732816a22094c08cf61475f268f413ccecd168d8357fcc6ea4445ef71d8d815716a441134f9f1cebf6fcf5cd4ce1bb70bc76e46147903bcb9f94e98c88f9cbe87adf8a688b92db2d38b646e35f1fe5d5d5c1c01b588d67312b1db453a592a15bedfe8501a6ef5892630e855c49708c70c912aee39ec86f23bf42cb09caad4f0c3fa94f14399964de407454c721ac268b8e71e4f028c22e8139cd38ad10097ae8b28e08694039e3d57d64103d1d8788db440ce75091bbb32bb6da7059277456c8e7ce8d320e6c7321f4846ca77139fde1d52de426472ec7de8936b34f66299eeebb5425fb78fe41dde18fb328186026b03b8ffb4391d44b0e452e330cf948c312a1a9b1145acc3f5697d5d87097d247cfba14f0269a1aa704d02b35129841b6ccf5b727baaec63b91de1590d59315b00f994cb7033bc88c6a1199f27db12711e48095e39637e12303910f1a3cfcf20ad7003da5863fcac2217b0a3cf132822b34bffc3d8da1236d745f2784e684de718dae1f1e5456686672857d72115ae67eb84479617c9d802b886b5391d500b287fc19922d6d8c78c3fd1c6a6c22f9576fc18481894d7d333cff09accdc32da15c9b1ce99e798ae165ba02c361ec7dc6c3ec2050d44137e375dcbedc219cb21452e5179b1bda4b42563e02c93ea889abd0f97fb3a59f08ee5b2b78aaf75b00e27e13ade135dc497679ea546d46c795588692862379b27aa7aa641ff24f3e7c923949d9a4c820b9437a231f6f97dc31abebc9f096f1ae340dc4d1fc8b7583e2472c110f1a597f1332b5f2463e3e6fccd5f2403c9789fc3c6a868d6f5437abd699271f0e271a7ffe93cf4d4fcab34748febc929da57721ef03b341af3c3d02eb2710c9a78883ea91817f2e7867d38f30ced72ec12ac98e83b239e0421c72cdd5c7ffc22ee0a3453c25da55d6697612cc3b78037e6a8b8c8f4912e62f380279ed0e30579daa29baf1cb33d0bccd0cce470553765f4bdbc70ecf20cf5a8884b895c985e240c200233f6a12ee0991be42d8c6fb0cb32db2bccbe9fdb78893d57f7ad95b9b545f2f845db05f8891da88be4f82484d5ef9d6b6cca4366278acc3ce031e043bcac4e8704a7538d27b7b66848d99a9eb0190bb26bdb22bbaa36c131b509eee8b749e6e5ba21a16b63ac49c6465d99cb8b1c4c7c99042ebf4fe49a599a9b57d011392d97686ee24b4149a6e0997d4d2e2f2259a551083f4c79b02027a8707d92828fdab54d031ea3acf5698dad88c98d0e272e357bc7d7c4b9c4572b265d730bfcfd5cf446fbedd432973d3758119360bde75c6a4685f4eec15ceae0efe7727e2c9b0afa5d476b65458557c295b1f105a2415f95e573cdbe176ce99af9ae0c752490638c672955699c5baeb32b733344125c25f2785e19837a19ba589a9cae06ebfd3bdcf3e17a7755d77b09fdb1abdad6f5df960c59ff31a36ef17be75b4c0f07bbd92ea8f088c1bb023bbdf2ccc6cf757e758f8be7b33a3d4013eb90c2d7a7c4a67902b7bc71b3a5c514f044469f753c795bef25fce3c0d5ef6d998e6b7572f37e6c5af673725dcfe07ff5b41af74dae4fe33892e3075f5a2c2f5077f14ccbb7311a9e6b6e35fa0711981b5899d340c4a1fe399eabfabe067f036e2f21ebe44c05e724936393c76e400a9ed0a5353ab14e7715749e2793e32bd4ffebc5343bcbe7df84b238fe9dccf92ae2b49ddaa5927174a1cd241b878a0d44a1cbfdc37b88fcdc05d7aad38888c9a6481e438aa07d9e953c1e9b7bfcee8975d79cc90a67bbf2993b3dcf55ed8ce42ee43fdaf6c7b7171e935d9e5dab14360472809a1c837ed98eacdddfc633d1a1f1feea0b367bf93cf765bbb384e7676cf6022d0d93df0795338ff06cefb7e32bd58feb2194cdebf00ce57cf8b13b3f39f6bffb6c5c90d18d67e3667a8273e0d9a52b3043f0143d9cecb3c0d21a67dc003f7b74d62ced53b37c2fd271640703a89fcf02c31b0243a3e2623b4c7007bf7d15c9f9f0439ffa2296cf5924c766027312c9a306f107ef6e844c0edf2e7c688d38e308cfada009367a1f1b223cbc0fd327fa1699d3dbdd74bd4724f507390432760c65626a08ec329489f3886a595bdb3d7136a7601a4ae4dcaf599f2bb034b26200c233484a6bae88c173c512a467a9021de43abc086b3c77df1ae18f186667c8cdfd59bcd66eeefddd294aae178027729472b034490945be23784b96525b95c6a77293067f476e5232cb86e4246c50979c542aadf88a0d61a1c601fa37156a8c4643c75d52529794d425257549495d52529794d425257549495d52529794d425257549495d52529794d425257549495d52529794d425257549495d52529794d425257549495d52529794f4df9d94947b20f3aca45560fd3890739767b708bfb77e10e7a50bbdfd452f3f7186d1e81ab23ae47d694406848791bb85c8a91f07e3782e46701140b604760b8a1123c439ca9a80115beff479136cc2f30f42b72e846a4f44e678169877f507896a0a8116a27fb82b8cf2f9917879eba35713cf3151ca9a19c2f7ee324fe018f7d13283345a26f2781330ab640ca3c97d39f160972392d6d7a6b1d3f7257caa71583eaf3c32657a55889aa8bb051245006c4814f024d02842f33856f5f2f7de6f6b98d94524590304ad498b49cdf8efd775cb37e01a298cbec00c03d1dcfce2fb510650f66e6b945369ec33fa106f710454cdbca2e8619562802791e34026e77e9c193369ca9819132afc7e1ca9c0b3cb308e5e981423af900fe2d030661265a5a3c99896bad60f8fbeeff20c5fc5358c12f624727e12f075cdba3683655d1426914749af9808df30420dd4e1ae21622d5d3f8cdc38f1bb1a3a6edc0b7bdcc693f429caf8b3137c9e38767acd705b8a12e75d9e551b71c6b32accb60a78864ea3dc61840a2a328374ae59e44bbeae12bea3e89a069c64f3e5d82322062df48c6f6d994ca3052735193911feebd7518a5aa71a6834a3c52c6a27ff5629ca3f9461c43db36ef8d636e09861186566ec9328bb06dac9e88b9ca332f9da36a78866a9c597705b88aa8b6821ceb22326d7bfbe1749649559c32b8d6b29f06b141539b936efc5736b4ce511174512a357a803d368d64a04942192c7418a77deb801c82b9231bf7ee8d3f86fd22798c7502681a1ecd08c0ee09ec7fc09e71f451e3e8fb3828cf8d01b719fcb86267a699055c22c894627266ac20b9e841d5d3e8a449b873c136591a6df49221ce7aec0c87e2a0b1fd103bd9f80b63948b9fe8d33bd74147ed7f8d0a73ac7d220ca42d937f06d316ab64847797468c697d0a658b150677b6135aab434dfd9e1099e98401abc35e9ba683c1220fc0e8d6573cccb255a49b2dba24c2b98792b8796ca333718451f0aec3a1da7d42f90e0cc9347bf8ccf381352b9a7975817c7736da1894a86752e272a19350d360351c94c8232027ef3f4c8c648bfbb45a534ab81284444c791b34dfcfdf4dcaa5984490476bb8c29da04fbf7663993d919534d66b7973639936633f3ccf0bc62cb6b4ea2dd9bf85b17f12d226248bb7c7c622e1491da4fd613fb93d8e1193dc0ec95259435f8873eb504667be69941698db51953bb69934d8e34cbefbaecf5433a8ea5b0538b678661bad66caf6105865c36eb9271d48400c561047c06631c039904278eb916e4c8f62265dfce33dae8f0018e0a11c34a95170234928f791643c29f69343e81dceee4d6635d5e17e53d128d31f223b38329687319bc014e302359d99dbfc85b2d59800d3aea6f993f79d3247ce38918e7c90b70e577e73b99b66213790fb332539cd6ecc5ca4c7eefa6bacc42de29ca974a76409b0c7fdaa6a9cf80a016777225a99af1d76c97afcd8d86b64d3e974c4e4c1ae690ed95cb33730c56da10b1b197bf9fed41d0a2ffaa993c78136c2a3b24ec18405db7625020e1b4c6630717f653d8dc803c2c30c3b3884b499b7d815942c54c9cf89bc5f3f439b701e248f49067973003cf83f4569bc17357c1218f54e78ca50eff2b663164f2a390d1df20e71a2aabbc67d53c8a3a9466f3aca7fcecd59ee5c4932094c89ba6eca1ed76bb70989b44cd57aa7a94b268382ccf1a2ed811ed99c975995b8535497759c0309b37cae24975759279c019e3b3b29b9660f34cea0759e259c641b93de16f8363505b5c9c4714b19c09e4dce7b1777d454c4378be5ab134900c602438fe23c12f2219733f5f37cc5c9e87143c9f62070fd29b881f13deb23c488f3c0be9ec3dc974186a9c31c652ba5274d4168d2d50209eeef833d95b881776e3a5593a75fbcc31cb109e9965b23e83ac4636e6faa65eeeb4673b9533471e6409e5fcd19a8dbde7b33da760850efc88ac98d4061f5e24f298ae3dcf60a964b155e7917faf1e975fcd3e4bdf9761b518728c3fb317391fa9291fa43a1f5d1309cc62a989260db37dbc0f3da53198e97fe47e100884b3ebe9bd9c5195c8d1c2de66599f01c76c6d995d435a7cc07b91cc4f337320bda23291c9565566aecff07f9c11c4d288c2dc4074be658fb0f2449d2e29564840b3b9e7772aa97eb8d6d3eba471aed9d9b5451697e88d95213f5ee4606aad9373fec30a23559a20df930ca686ca3eb96e0dd77995aed2b931d2d7a1fba3e63c7d82d95e3c4b15e70633a12e92314ff722c9362bf35ece938df3ae649f376760d5e8e13a9a30045603fcec2e5b2c9f5f8e0ba4808b163e86f8837678e1ce804cef45b3f37cc23f51d596517a5f06efa3f99deac1f756c4c48b328aab9506d8a5c6930095e019759feb200aa78164c2ec41d4e3593ae4181950a49adc25b7ec51d5a6608ea184cdcd3b9bb39d970ab4418166bea633fc3565f3e6f20ce26d7951621b17f2d8a830a62ee235f646c697d99e0d69fdf0d7b269f38cc05c8e3dc659b54a42f51b79267f221778c36d95d9bc01063cb3b9b391d20ce6a23d43cf26d7c6aceab25e4ce4d3e117e4fec15aed0fd7e5eeaaaef6efd782fc6f96e7756b7d9e462bef95efbe5a7d3ba93c8677aefaa1999ff0e9e04b59c38fabbe14f546663fe7faf68c2df7b5b6764b567c431677615f137d513c6f3c999d5d93c98af340326e9ab2abc133c9db0a793c7fdce992245bbcb4d6f7eb7ac1e375151c65a37ead71350feeba2226d89ab8aaf409567699f81fbbf3bd6dda547dcf18433d5c38c73e6b0b6daeeb45938ca8b569329e492b40e677c6657f48c253f0dc0312dbc9a5ca36d4231efa438c6c22591399832accdeeff561c9beadd8b1e1b24ea7dfc9d3f5ec29be2ccd6565c4675a81195e6576f38cee28eaddfa2a4d2709adda83f05c93dcd3d4da40a91f2ee685e2397be22734618be614958969fab77cce2cdec9d7dce37d142a7a9468ec57e5d2ee3f492e6d06ff3eb954da8b943f635b57afec41b9826789ceb271621df140df162af2b48cc5e14b0079f2ab32b2b4ff04b4c39697921db6a8de2de4f725cdb67bdd3922ae0606f198caf3648e79b5b8c5f422a4fcf9e57b8426d943d7df1fe7b0a1bc002e942dc9f93bc573cddd5bbbfcad8925b83f0bb2b17d5da898fa64759867ef62caf2bdde764c2b3d1c35d938789231be886955357dfa9047924a1d057f7e8b9cadcae592dd9fc40490ea8d5a34f3de3d2f534115afd9f9b5142b909d5d1181ddba9067a3b5ee50bfa0531be3941e8edd6e63177cfeedf786b9ec7a4796fb926e2b54a2dd5cabf34a69e9639f55dabb8b5189f11ceb036a518649c6aecea150f9b912c354d6851a67d217717144aa36fcc7af9c1d8f6a629367d5742a31454fe3f09ad93235f72f7489fef238080a9f6aa271747ff55b77fc52a6df6779bcd9767a423756d794ddcf146cabd2f7daefd0cbf655754f08e8d318029198a43a24af4edd6e33657442cfb887679cfbbbd172f5451157231f745c0134c3b307ab5adef3ecb6914f6ae4407c8e515bf0f758573fc2d7a3b376938cffca778a95bf9fa4eb0d569143f77723f7765c7e2ff017ecb87a7d65b5e98687364e74dfc01c030956a187f60373cded26e386f2fb5fac5e55a85ad8284f081acab8b388a150bf783279d44476fd253ef9d8ff029f946dda5fe793bfcc1305fbbe8556e1dd0c4f8e3db8ef2b760b38060d1fdab2d939b1799fb3fbf4369f7af898374412843291db8a85bbabf6736876fea9bb2baee2bd78c7f868bf1edcb9d7d3220aef1c78c893ccc68bd734add769ad7b7584f1338063105529df5966f70877e7a05f905f116fee508467e6a9fd9deb8efc7ea24d877d915787a60cef4303f42a1963480f9a484cebe4d27575d28098fa6298215831c57ba1b27c2bf97f88011a55716bf2179bc8f3739bd554b1cb621e273a45a87a64ebd7e3d7e7f12390f0b5975633e598ebe8c3981bf00c5737decfe0aca7bc5617b3d97a5f90d348e3bfb491d89cf5f1914df2a67dffef78f3deff9ff863938aeea518c5c5e4effd768d3d5af4c557d75e3927d7f9fcbff48de7edd0a7657bc93e69d0dd8f7d2175b8cfeea2864034daab6636cdbd2467137fb88ca5f760535dc46ee7067a7ed61752d56d51ccb1648cdd5fe389742e635f5e2c2fe262ad8ae45ce7331d56b72ff7ef94ef2f9fe4bb528cd2b3762295f893a339f8f05cc9e7b2a3fdae24bd679e1d40c9ef98ceb11cdf71bdb33df77c897ea185b1c6b8b0f91c959d41335d9adc7ddfe8b21d1bd2b3f38027a9ca5adf0b7effa2accfe33960754fcef0ece84ecc005e568176415f0566a0ae4db89f50f7ce0318d707f57094bf154c2e3ff4e99e63784d32ceea0ffd15eec1d7c688e9f86e9c4d54ad73e908ecc613b0e39037979ac81cc374bc8846669364df610c570e5ff19f0522be8d7c676b720bf8e09cc68ea989cd90c5f715f05039ef2f2f30f782dfa9217d82ff628b64a77172900637a40714860f38187f1655430589ec83bee42cc6ac69cc2b4f1ecd1f69bc993e89e5e4e3f7eae6f27fffd66aa6ba292bb76fb0f8447b29d3025c56cb141b0fb0965aa6a3df50e43774b4c7f0371c7943d16fa3d7e178886008f6e5aaa6c3bfa5aa693cdf86aaa6385657d5748c0f5034ad43fa1d41a3f9e3f7554daba0d9526bab9b368276d54dbbeaa65d75d3aeba6957ddb4ab6eda5537edaa9b76d54dbbeaa65d75d3aeba6957ddb4ab6eda5537edaa9b76d54dbbeaa65d75d3aeba6957ddb4ab6eda5537edaa9b76d54dbbeaa6ffa5d54d0b0ec9bcb4e94f5283ffb0f1ff81eed89f8b3884ffe799bec0b25891eb36bc5e6412202279886188770fa632cbe4bbb7da0fa03bf755648e08b79b4661f83f774b4c608e78548685a0d4ac2ce80cd113f7fb98278f98c0d060c5c6e10c2b98fa64c0b4acb9bb62e913c7dcc0cffdbbbf26a8f89b64f43d95c3344d326920cf10fd78dc92abbdaaff84e18bb39bc7b35b8d5a44ff083390ccedf0e76e79e1431b51d829a0f43cf4e9e76e095de230150a50a778fed40ef1923556d7148567f1ec1251f6882e62d22881d3a3f08db824e8982ae22b48e60cf1c44e61d91595278f21872f6d6911851034e1edccb3f44932c0350ad5d0a9a47c4df13bef30742d1082e985d7a73311dbc2701a8f67d7be44de50299878d47c6bf1bbe94924e7a184811345c845389322a3b0247515c0909979c09349ea1e335015668c4a5199a7310ed3d6a9dd7403439e61d8894caa69bf21190088c636a4c871127a0953d7e8281d475c9ce3b27dc454577653185e08c3607d3e986a14fc875683699c8203437789692890f30086d5518b68deb668f097955ec0e1c1d664639ee06f93e14ec2e84060a70845de34d1905198def473b7fc8363792debd3a7d1feffdc2de39416ac34969e84128492b11e538686c88b69f8a1bf5e386ceb0be6dae7b0db4566b68648ce037e373c891872e1185b53081896bb0c57f8045d1976b80a273ead0f6ee933751abcaef0642f67398d51b35bfa6d55c297a79f3bd99217dbab145a9715469f457d188a18edf0ecc61798d78b848d4f0276445606b8acf6ef3e4d0ca2ef2dc3d2f8ea6a87e4f8da3ff8069caf41bb227e3cf3c60d9699f0396cecadb0f91f1cab5e4412e852302ecd63bd1b20abd3a13c9fb6792c92b604b74bec57704b5f38cc0332f1ffd87bdbe744816561fc5f792a5f77cf89a2ecc6fd2624226a4cd408c2af9e3ac58b8b2820577cc35bf77fff55f7ccc08068cc9ecda9a7eae6436a5718667aa67b7afa6dbac5d84e1fd2c1f279f72c17e0021c45afcbc39ee19bf082c36230a979a3faf04d956b5bca1f20ac7383215b8f35df698c45489965295ae0caaa87e9b1de6a3eb902d75a3a8d675f5516a9a91b2d351c9e603df05ad944c4777fa94fbc0a3cc0fe29bde3f5cec4b3babdc05cd67cab3bae39dde71f83b485a1db4e2a46b6d08274650b4709008f7b7b827ddf9b61676be947f1753a5c1bba18196febd3f363dbbf00df37d95b3f0c04cafbbdf503a60ea27bcb5402415582d5eb0452717420bc0af85d62cc8627fe19ac3db4a5ebb1b2668667e9235833e0590b283eedf8aa47c69eb67ac2d9d5ec08bf95e1ec8190b5267edfcb5348607a572775aef7013400a1b5849fc379c042fe487f6729721ccf10203dda337d6706bd9328614a94c7c39e856a66b029e3d8f5a5d87cac45e55420bd54a261e4353f0f018e316d079e3bc0874ff43b6ebe6c9db9df00f7fa79294e2d2538a9f857df43ea394b8770493370a2219c1f9e11ad3c376c256637d81a7af361207869453f8adde86d4c7de441d8bd23c09972e9dc405eddb785d6de6eb41fac47428f597fac4f58a3c6b86ee807becf25e0cd08a770364128a5f7aa3c55d1f0f27cdc07ff355c044e387d18084fdf5ee5d6faf9d4ec17c605da509e3c1778b0a0d55e27ee6f23049e9f44f97ecaf98b0b675517c205094f992ca5bd2db40eea52546ce19840aa1b38ef601db5acadd4b668bb57b9b528ac25c59531916a109e6bce46ad5ebde5db4a6767a54ed53c0fc62c3818fa6167ebd3fd246c056f7a27358445e0082bff7535dedb2184cd49353b85b48bc7033b5f2da505e96e77e6e9b037defebd75b0c25600570b07613d5097a26ecd86b12b4bdbf9447a03d9e7c597fecb16b4ad3153014e5cff0a9c2fd4ee18c2ba41e68a6602a4b61737bd545a3ba11616cf1698c3a26eb07587ab9942eb301078d9483cd85ded34688c53539fee6dbd7382f0f4de49d44166543bc3bd3beb41ea6d94592ee222e2e8f391e341f4bdf5f8fc6d02a9393ae3bdda417e037ba456e8ebf158b71f4b6bfc784cacb7e603fc194b71faf6349ebc002e964d6fa237bd91d05a99ba8821970359aab9c283c7ae00c119ea281ac3ebc9d4a79e2b2cf6206fd9beb435f46d4065b985d118c1f5ac9a0561a1cac1b305c373954560cd540fe4183b1a9fce7081a95c83d4698c766a677898ebf585d318e3b5025308204d1af0c22d49d9292de19a98934a354be9d4280c07b8b6ee42aa9554da422a4b6b2625e644c2749e4e2ac15eae59cad4a369477754963c39c0676643b8ca0d698d4ff6615d800be153dcd80db593aa3c7976a46d0d4ced315e1bb311e800a9136a274769edcceeb307292d61beaa22d66dbd07fb0ff8128383c18c05ee215d9fad0410420a2923d7e3d9626103dc6f6b4f5d7576965e5fd872fba4fa2b02af1ea4556b8778d2eb9026ec046930e6044ff41a46be06265d037a9d965d73015cc636accdccdc5b3a5ef1019ced1d610aff2e5c59f24dfdb827cfd9fc5a070c1927f22d5cb1d9a99d60ef8eaad60fe420a087e3de1580df8ff7aae2d6e1eabeaa34bd7eda3eaaa03f60b8afbb3667bd009e5bb3e7f25a12f81b92c8f617c3cdc0973ac0b7e73aa4b5961a20af937595166ed88a4d19e77ec677207c195257398d616cfbcdecaccecfe4727a3a48f3ea7c7b0b1f20e41d42bfa3417e463f0c8415f07ad86be5fde73bcbb36789f526f26716ced9984834cd452b64eb04e3a85d776de9c335ec3553997aec9b7ed78570f3537f22c5b62f2dd4ee30706509e45f16121fc0fc612d546511c37ce85e8a20c4dff6257f7e8637b816d8aa5918264faff9f8701e1fe302af41de7d8c5f83fc2a10c841d66ce4418a043b2df01208670658167096926b534def0de4896c0ca4d3c0807912bac23571042d81d20070e61b8c2ec8fbc8490bfb988651737f44ffda032d017c06d297199bfa7105746cce7aa0c709b8964f64bda144447f726dbee3065c379a4fea21ac6101271309afe738e9c133f5ced2c2fd43754138e770ed0f148ee302ae4142da3908b5071e33745e66c309a47041bd3372f5e3c2f1a5c8098315da137c895ed5ac79f48ac0ee6cce4f1caf525a21eaa0b361a0525a013c38f027605913b8e29bf1e7812c2db83d979a33293567706d8fe10c747f6de7769f13d093cd09df0674e7ce4e05d95080bf60376817d74fa5f8a77c0469cc49f93e907676b640c78c029095777065c349afe204f08cd7f0885c9df30927a5fb4158ec5da149e8e771f6189ca69ed9e8017fd89b7e3b1de66746c39a8dd76ad75c3861b077615f22bfec9d0632a54f65b877a25ee034a4bd1d32bef9e0a17cacb4c20b7488b2a2aac0593bf5f07a1a5c9f613075c70b233c06035f9a927948353c5fbbe3ba138aa7812fbddaf9fe80d4e009ed77e1101b516ae99d5af96cc37314e630912035b76fe887222f8292040a9ea1347d22f24b42ab05f870be81016532c20cfe1de825af2b6d61081eeb676bcc56b01609d84a6c413c412a48a445423327d80f361b83f184caf383d122e05efd56757673f35b03df61e733f28e120f745290594431db174539616dcd9ef74093aad26ad87e46ebd1193ed1de84f47ec22bd28d618cb89321addf08d2f8d7c859d7d9cd39fed35ccb6f70ddd40159495904f3095ce31bc6204bba4a27013b13e8c1c0b321ad399c7b400ba4afe1da165a27c037c83e4e383ac3a3136a079049803fc11ac3f8c05becb085e9de54a5273a8df102aed83afe016882d9bd0057646fcb070f6503c039bf97fdf67bfb0fd7bfd7786678cd74284358ec1d41dba98a999aba96cc2707ef5551bf013fb78126086e40968f9d54dad98d91d7877d2f4b2b5b00f94bdcbb785556ac197ab0eb4fa4851dad321933e36d4aeb9c1f42ea716571814f4ba09b2436daec5a0dba66190db94ab0352794f69e2abf47de620898f61ee80ce641e42f841be59fada18f838fac1f276b06b6723c515a0d2d5d4b805f398a96c2d52d229b2e205d29a1eb6c1de05a12f689670785835edfa7721847ffd97e38970550e606d88da5d8031e6177877b551907c05b6d3dd899ec4c86925d4ab075f55af1fca1724cdeaefafcb5f58ea82af585254c19cf01f9b3e6ce7abb012995b1b4cece13d88b015b9f8af59312e0dfc097415633964d6f0cf4aec0b92b0598327566821cbe75914791f18dd908aeff41aa79cad3a9ccda9540ae6730a5d66cb8c9cef6460fe4e4b33d49af307b706eab4a507352a4e39339ebd44d5d5c82dd00f91af04c5c834ee2080f1e9480c079e9414af93dda475c5d049d3d065e017012bc93b454d87ed60b0cdd047968a73e1ef6b6b22adb3d1ee0d988b4f18c0bfc94e00a6546a6d7c56e37dbdbc8b3d93a0f727e758146c9ba92b518c27ab3b3e6025eabcf4a23d4401e623cbb8a9e9106a81d3ed30d2d45dbaa0aa4063e507c82fe08f617dcdf9e262c02e0f7553c02e8eb8ca7b03460547e23f3a770824c353303d029737c1ff78effe87bbde7a21c251f404e5fa94a2702fe0cfd20cfe4e49e01ac25d57fc04664088907364bf8b3f4e64e7d0a2ec91b9c7e837c95ac07ac175d0b8263e22b017a735229b675527ace55089fa6b8c7f151969fc05e7cf02c7d0c325f6c8601f0bdb505fb9eea85035fea99beb4c8ced073fc663aa9dd009a85f306fbbe0c27dd0397e034e87e813d51d127e55bad5dbef660c76c9d48bb87b37deb0a706e42890eb093181ee0e5926c0a34728926dcae96dabe74c2d20bdd155de7a677415704bd700adfd1b205703613dd18e8423f269c1c85f22e9c174c7ea77c9eca13d4c62a2fe05cdb71fe28aa639a94ae3a35aa4fc594d61a16a45a8731cecf031c53551670ce15c739a3b9f69ad1033f6e7f5239f78741434bd8d9f82ab7c0eecef81667bb770b696a7be9755b34d8cc46a06b7583bd3bc9ce7ab433bc9e0e7b789fed9d33fb3ac81c75182f7a0d82c03955d8d02fc35991beb8ca3e9fafc12ba4ea25df6629a6d1272678277e4e0c86cbe3d4f796d0117b27f115fe7d955b6c5e199c03611cbbb95f81f371a01c03b645ffd5bfd68e4f33c9db2d8febe7a25d036c94471e7e52b68ef6c99ee1ba315f8c4bd347063ba7abd5f835a2632486de0b6c48fdd3505b3d9a26c90e83a525e07911bdaec4d810b6e8837ec1791c174698d317fb7b55c818742de9b8f9b7bd94f95a5df4b5f640ce9a2d12531723f0f9abcba703fa4505d24fa1ff731b6eb686391d14d2b946e7f011b8a15482b3ac45345d16fa9a7b277102ff7e787eb3dede166a60bb131df03d35303d4904b2d75c390676e8d6acc79afffcd6465f18852dc7f139ddd3b1d09f94ed470a2b4dffea14da021db0b411400f396c6893c3f3bd771247c66c78425de2710d6312bc9fdbb7e918c49745c73d64dfca0ef35b460ec0d60019b962becb0fcc17705ba2e3d76e9bf20d2d75c2169f1a3fc2bdd4685fe1358574abd1eb0af4412d553b88ab8781407d7c6c2fc27859fa91c2bc696cc2347a5d0d5393d803904e2af800c071a03ed19c8f60d91bf5db18623284a28f0ef4ad82bf2022f235f8ee32df882fddea4fcbe6424b6a9df97b8cf3b1f6ea5224fc1bfc28607f65f450da6f941e616d7ccaa7327fab1ae6fe564c9335512fe2460d8f81313303ba6ffcd715f97d1d37d9d8118dad29eeed1bf86481f796e6939725e6e02eb5b1152d2cbf2f9e51bd3ce512370efb7b55bc94b3ed9efb63cffa7369daa6b3bd7e8d5ec1fe18bdae90fe3d281f5d49a7eff78329047b27b10f7c447dc2d2d495b8e1fe1e20f51aec5935ea05a64cd3782d9b0559a4f007fe3599e2804ffd75e337900ec8d4cdd80eb5d5403fc64e63843cc7d50f37cdb52a051d9c035cfa3e4f23e9fbaecebd8a7f57d1dcf9b95f48ffe457cc17fdec23b06dcad208d22081dc74c1f74e798f8bfc7e30035ff5217a0d903f7b53a5b5b71fb9fd5dbd2ed979033c096c70a6ec50587b589209614dd56fa3b003b63384e92a8d9dedff71dd9cd139a7eab77117624dfea09f48437d8ed88ad4ac3f0d6862d65b823ce8f8a45f555ebc4c6be6acff762e73727f2559b0b7041b862dd4b2be470d2835dbdaaa1d696108c9759a28d29a0f6975dc94a44f5397e2c4d43b89fa24be8d03c79b42ff15f2f07bf4c5f11e2e0d55256d30190f61413d7da66e1d48a5043ab14ee5229fc8806a843ec45485381338777de999ca6817652380879ef5595c13ee2f61ea53186ba4affac98692aa182f04e7de341fcf97b696de6ca9cbf66e3851c17ed37c9da875d597044387b49d35ff79d23ca83ee81edae175a21e06cba9afca0fb7ecbdca67dc1a42e9f7b33dc89f01a49ce8d5bd773240be9a38df4ab14a4b48d93798015f74c0af09256a77af7e1be3fde0ffc5ef19ff053e88fdc03787aa35cfe177d18e7626dfd376503a3fe37b90d6a9abb1d83cecbf0407c51507ebf9d8fcbc895d135218fba5b90b43383bb616dae19d6f2301f0b8be346ed60f9527f8b8ada2cc774d2fa8dccf6e608790e20aec07ceb7b12eaed40eda402eefe30b7d17e582966f442b5fed92d25caf935e5e2222c4f835ff06d840b65ccd27ceb769a82dcceeea23bc25021f84138da8fee87c9b92dfde187ebffd9b7c05f18129b42ae701fa869dc72763fb2af9a78a3f90b847959e0b844e888e51b1c700de2e94a6d6965437f10da02528fbdd3897ddb8bf07bb2161ca5df041027caf1315f4ccc3abdcdad378eb8f7c7b04fe63ea47f0dd05e663f3f887fda4eab299fee1b720639efef0db9aba6cd6fef0dbbaba6cd6fff05b415d36853ffcb6a12e9b8d3ffcb6a92e9bcd3ffc5654974df1cfbeedd5edf018db7a00766dff75d757a3e561cfda55ec25e4372c3ea3fa7d5106ea09e3006d1fe1744bf682f36d0436d586b63384a94778ede5fd0f700f487abc5bcecf92ec4a4b5557ecf5b2edc209310696cadcadc6e0ed297a6f7e994da7318698aa08e22346c231368484c80b8af66883af43d056fdc776f22caf50f6bf32d77fafffc927f7ef7f72ffe9e7f63f7c7ffdab69ea267a51ff32bd3c7d32bd3c7d32bd3c7d32bd3c7d32bd3c7d32bd3cff657a513f995ed44fa617f593e945fd647a513f995e467f995e9e3f995e9e3f995e9e3f995e9e3f995e9e3f995e0a7ed1bf00efe893e965f4c9f432fa647a197d32bd8cfe9c5e6eb011b84a2b32856376efb74aa6e6e0dd9b829b98138881455f47ab5787f8b221c4d1420c74e884ad6de17e878f31e95d1a279742c97db80be5cad97dda08fcaf03ff365f02a4b3372775167797f6522c378df1b4e6a3988d73d55e7bb95f3e3e2a7a0db8797c00bfa8ff408c9832ddd238da85abb4d2de497c73146d097702ed867add8e526dffb88a13322e8bb1afaf2cdd0c2d7d58b7bb63f192edad64eb297c33d0c5bd13c2fd1407cb83187a2f31f5d1b737b0abe89de4a36b0c7701cd3058c2dd9df9a4be061dad771255f8f76a5f17e8b8229ea0660b6e74e683bb80233584fb89a09f764e2ed8bcfd022d3d1ab321f836afe2e97c0d7b5b88671bccd04ebd62766ab3e25ee6bbfbb782dfb3fd7a233ec1f67e3275f1e4ce9eb710a78fdf16f7cc1b8dd1fee03c8b7d839f12e27e9d06d86ec547eedd9fd27901573d017805290dda3b892fe6ccf9231b62e5338e1fd27b53fe359e692bad88c4829ce1acf21967b3047bf212e268cee028d138ff3efb3ff0207acf99bba30e771a2357ef4460b7b4c28e60b03c0ce777c3cbf90568ee80f854bae7f85f701e807d9ddec5fff6574b102c93eba507969084844fd94f2a0e08f5e6cfe643b32efe2c171e68fea3d6fc87507bab8bbfeaf55fb5065f6e80a431b95e6de047566d4060d506ea3f1e5a8d0f551b60d5052a8b0d3c54d51a787868356bac2a80d868fc78683685e679ad817ae387203efc68b0a6b5ca1a0385de9a3f5a3f1fea0f0f3fbf6a0c7cd518f8aa31f05563e0abc6c0578d81af1a035f3506be6a0c7cd518f8aa31f05563e0abc6c0578d81af1a035f3506be6a0c7cd518f8aa31f05563e0abc6c0578d81af1a035f3506be6a0c7cd518f8df5b636099e4b505a25d10fc5daf27f7e63d1768a12573860a425dbcb1fe7a53fc5513fef9d0ac3db46a8dc6c387ebaffffc1bf5d729bc175ca2cd4a9f68abd9a809cc8bf9d06cb67eb67e362b7ca2e74de954abebaf5f6afae51bfdf28d7ef946bf7ca35fbed12fdfe8976ff4cb37fae51bfdf28d7ef946bf7ca35fbed12fdfe8976ff4cb37fae51bfdf28d7ef946bf7ca35fbed12fdfe8976ff4cb37fae51bfdf28dfeaff58d724d7847e99dad6835b53bdc1bc23670e5764b55827dbf9bf455593a18b3f1fac5971a1624ae6d383b5b692da1a08d2d34778ed2a959b4f0473f6dfb7c8166d587e255d2d1d5b5740ec512a32124d98e6d41ec41823d554e3c47d0a060d1de0e8635bbd18304dc3b53d06a93d938b0279e9fbd5f052bd55ff57f4f563f55f961af3e0df776280658c85279807e52282c287bebfd2095de2cdd85621b3b48a60a05d0c7fa31b0752c10d51fa40ffb4fff3b201c4fd6cc3cc9e11192d0b2a2c5deeb040a250deb088f2c46e66cdcb1150d138a8fa2a067ca9e6f2bad0524a055fdd54ffa3d5cd6c6824ab7f60117ba5da503098cf97e3071fe182e343fd63c53692d5dbd0e05dc70ddb3757aea89137db42e150f885f0e7162ce461e26305f91e4e96f593103a09bf1de6810380650b4f50912ce41ff63793e0d567db91738516feff8f5ac7002c2e6034e6902fc275274c8298c9527faff08dc5ca101bfff96f491ce15784712f27fa42f0792a697d720c893e07fa8af3cb93dc235481fbc11bd045f9c374d2eff81be61eda1387669be8f34d9fc4db4534a508f30121c91023453a513991329fbce39ac73daffc43f02c33876a3673a3780477b822427b7ccab4cc798d8700a7ca127debe27483251929c34ef8b246b0d6e86852577cdf144921ede0e074d9298ef6d52a8a9e3c67638debbe94dfb917c23d7197fe7e0e98456fd637df1055f389e430b16f4866ccf6baccd2df38cb262ec459e71a1efdbd62e2bf8c0cd372ba8721bfe200978785ccc8b3410dd8ebfe3de4ce15bd83b924c926588d97961ea1dc1d4b070c18df4a42ddc707a814f4172e89b70c80a8f04a65cea638589fa3fc23f30d134872b5a101592df6bb470e64d3071df4db7b4482d87376d679264cb37c106459d06336ccff521066e0d78c9f4a63eec506b0c74b774aed024290114751b8b37d14044dbe66b4493a6886f9864e9e976988a899a73dc111ec792b96a94ce6e5c2b4583c4352926422ee292c139b185633066c5e2fcdbf009df0c74566c50ad5ac3bdad686f5c32a7dbd6e03c09d4e5bef3444e1feb3bff2eefbb4b93384dc7b1199ab1d1d012b7bbbaad5fe5b898eb9028bff0ed39dc2bfe7df062377a5b433fdc34462971d38e7efbde1898d8e9365ec67fb7da5a40331cff60097edef4d6ca9c0e174e4383a2e9b7d00a49c6a4b36f2ef4a9d1644d37c14a924661a1027d54b10650241f0ac70deb4e7d18db21244cbb09d6ca644d157b864bae34e4132ddd844b3e51d340e7604dcfd7660485d666a3dbfa256db7ecdb0ab825334c6eea0b8aea0ef44e6855c034a5ba5aa6374c5b9b5bcf5ca6e771059bb6b6b02d9cc16c1c2c22a2d3e2bf37d145a108c9597f584c44f9487f85622467fd158a89841fe8b7ba38c959ffaca0082bc879cbfa1af49bc10c8b9054ec0df164462e2da87bd39ed83982d9e0faf350d781336922c13e4fe7230a373cd34841999b6085f6933a16a4e16815cfa991d0aadbd187fa6189fe993cf6460b8930fe0efc259dd2a226b7e10979523a980d1328c0e9f8398e90f7cc7a748cde3329a072d37a960b9d6c6971b64c8f9cd2f3447d82a24563264fd6a190b419063b531f3f6391efdb692db474118a2e25f85dbed6a9a98f518ebb6d3db8c24d791f28236221a4db782cb62705956ad99cb5e9aa60e3a1f62c2c8c0285aa6e838f2fa492e3ca11867b909b60dc0ff5a3a3bc9cef212c8a2a7e4c4f8986350364556e7fd3023b37d1366dcbede361e2a2be4c8a887c48df558293a3307d678deb6ee962ecb6ffa3ba7f60869dbadd1db1a2ab277336ae410244663761f2d414e5b5764b957b59b25175c9d6a120af78af93b66fd3a498791b9413f05d9660327bc79fbb38062b94ef6774438aad009e42433f9e4c6643e90cd776c3a18572a5148b5b43925592f8d263451c9c43cccedd21c840230ac3000ae2091dd0f756803fb7dbab43df55f250b9ad1942c1eae94f39a4fc9cc8c27972d777fac635d5f8e4b0629e8812e5392850310c5e72d8513f98724954df85a952fe2a8dd31da7ae7ee19b1af7fff4127c847e4733ad660b666c2b50dc19ec7ba45836577cd233a31e3c27766c2cc2df89707fb463d495a7a86f8ba48814f230ade9767b0ba331562099ee3802bb36eeaf35b38d0cc2e3de103a88e741b858388297a8a817b5286f449b5d5e5453fe2bfd738536f33d0cbc73823643ec138b47d242d2d4b6a2fe276de61ac878ea13ceff440b37f6795be8206ced0c926c19f6e6ba3f69b75e7de92724daccfd0fdad69a319b28b5f5fa076f7e20496539bbf00f6a1f7db1f4e1861413676b0dfe0b28208e30b076d8d720023ad282012d96cdd10b6b778e3b28d0dcad011f6fbd14e64a8b7fce9e3da4a511a1ab51a31718b371f0b1f9d2be3484d91fc8a49d2a4bbb6c7e6feb339bfad97c000ed60e788450dbb96107ceb384f697d3d2e3facc16cefa83b6e0b391a3da4fe4df1d0d8b78bfa1ad4acbf00b05fcdd50236bfba1f992029fb86e133551bbdb169c75bf995c301b068e2f61a149279526a63ef250d6a06b0cb2c1c7c6435a21320f474f6893421f17c8382af8bdaec121c3daa81d9461286da3acb0b3057189854fc0762b7bb1fa58fba6123fdcc916861b7336da997a3d76998d2dc03e783cd700b617bf4d8b5e5e84852b440978c07efa7cd14a5a9cf24330557c8fb055d1002924363ea94f901858ea826f470e333bdc87c6057b1c2613be4c03b1934ab4101a263447f987157e67b204dadcbacf1e8c0d05dd41d79ceb5074568ab9f37842fa81026c20db0f37708ef5e595f7fad6243491f952c5008a3481cd8b7c03f0254c36f8a1ca1d065301e689d23ab92cd17af7b950fc1f793316db971a861ea0ec430bc4e7f08ee22a3be2c77049f08378c13309f67048e447538784ddbd80f9acaeed214b37bcbe82dfcd78de3008694167b9fd4d7d6cc784cedb556bb7cac6439d8fac21e33d9cde98f1285519ee5d5dacbdf8057d90bdf7901ec97ec1f75af98c9555ff85ee6dec7f4afa63b003be9fdf005e09ce7b90a9325e60e8c7c089466b35c042f64b038b668d3ca7a1f9301f150b126a5ba73b1621f139d311a02f582f27d4b0d822274b15cf8b8e047cfba47679394c42394b0e4b36cedc0efa41dcbbb1ab0cd728ef6534db4a4ce471fcb86a257f9fc2388d67cfccecc295f07172e7077870448b15c0d9a14c91bfe019ad4c7f14647dbf92ef8d60bf3aa9c4db71e1dc4dccd13599f5afc157b01f57ca030cbf4f8b05c89f63de769bc3f8c427fa9fa0bdf543fce8047cc41016857ef04c83e4f2dcb317bfed537bee555cb3a202e09fe574ab4c7728cc63ca0a137c1ecc993e779d0eb008ad294ba86365f4b902dd4fa3effe0846ec8fc286366c8089d99cafe39dd9ba2b6162450dfe683fa36e48f7b30dff87fd1c66b6f5abf89d327bf613af5f9561acd4ddfe64fd78bb38d9e30ad7f709f910edff3adc23ce5e7e33ec451df273e00ff931aae70085649d549ac2792087999ff343b8077fa78685692fca46a4584de3d9d39400f860e8eae2d2550228ae52b3f463408af36d03535ec40359faaffee402efa3eb84fd503ab3408f981c3c6bb9f62cb617471cdd3f916f9c54923016a19df138f2fb1a8f5082509517a5b807f1e4760366336076ae16b5f1ecc0379ac91a4ac7b71b5a8dc5230c225268176d14a44830e820204befc17ecd6482dcf75f3996a786bd04e475b02541fbdc7e4ec61b8420cf0c4f6fa86f82acdfdb3b8ab623f09881babc245b808f663c63b1784e632cdaca7497dbe6b02fa00fda47dbe3e21a6e6cff2eacb0de82395191067e8f4a7c2cc727fa09d42edafefb557e990f9dabe1700d8532d07f083e18c25bb1e839f05603fa9d54ef234abf2715fd376023ec2cad5c9e7f7275ad46fd241fda5b86b058d8a11b6011fd2edde764be208baca05fd597aeee391c17e22cf5e126e34501efe3e9517fcb87f8d0d252a040baf648bea5321cf88a80f7147d48a5f3e8461857c3bad318efedcfc025f543fdd1da157c547f71cd42e8d7299fdd330bf66d77bc362712f3c978d60c6023b461b663e2b309981f485c98cab8e3866063471f4d00319a7db917db9154776535798fee8afe2395d21de80b5af0e24be5f1a85e43d712ce788fdbaf702e8566ec84f513ce63b68a9c70ea810f57553a21b74fc0fffbb1fda10f6bd6cc2471233ef02f73f31eafa53c1adbbfcf6701bf539fe283a711f0c587d6acb775d2cc8ee9d9cce79ccbd16fe5f8b137f4275fa51b128fb6623e68ed03fc58da838e0ef0bf3b7f90c3683c33837f1a6a27076244050d70b5077c115a3bc6e033947dce9efa797ffd7c3fb77620f3d2358498a51aee1b05f4a6a77ccd1488755bac8cd9780134a8ca6d9817f040b08b7be077e2f104b14c10abad423176bfbd36954ecd988827c011d8b427fa283fc3211ebc43f61f7baf2ac14a5502d09103a3d10b5cf9406c28a0270a35cf12c4bddb40bb0ad885960c0e235a79d66c7c52bbac089b149bcc265a11abee5c8c553f785530bf1c6eec8bfab026fa08f636aedb20a230ad82156f2f20f6155cef9d351b76e64a7072956067d6d91c5699fc406c8d502cebbdfd44e044bbd8f45afb929cd3d50e306f730af6260de9c19c10bc0f42fed98aca0e84360cc2a7de87097855345e803fdab909a60fc494ccd0d77a52fdd5ced2c59d39eba9da53fd597dac513aeb8d600c73d63b81bc01f717288e0fec393c537de981d8b5ebe576e8df714e8c07c719bfb48566e6ab3061eea03b4c6ede47dc3768db44db36da0509bd9fed23571f2e812e991caf72f7026ee1cbaa2c456017bcd6be2c3357df191063b8c7e104e360de85788db67f4e4b666ae82efab5fb32a51b881de90e03ea6be9e09d828c1f4f19fea6e66c41e31c104f5b477040bf45df578987d15877ed6fac3fed2bd899a984382fef53a7a12d0de017e97f706fdeb856aa9fe1b8eee0fc3525f379ca1eac1df8b13c137cda134a37b35e6a37f2337410063b9069342c5a073e4be8e76967cf86119cd5c0bfe42893bff6b6cfe297ffcafa63ecf4dfa6ff323dbfbffe457cb9427030a724663fa3e351f55a50f94e23eb3fcdce004ed64a6cc1a1f3217d81ecc362ebb2bd33c9cf361a0b02bc80c610d1bdb75a4cdfa607dc7b7aaa7afd949d37184390224f0b6afe45bc31df8bd60b9c8684368dfcfccb7500842f935fa69eab3c786fa1d6a0774a6ec151c39a49c1286c810eb7cecec0eafdbc30c3edcc98f580be2380a720c3a1ccc0c10bb2d45327b45229fbe65679b54017efebda3edc9fb352f5121f02b8072077829d702017f5a8aa351ce9e6467dda82de056b08df7f441e3d95e5df0fcdf74c169e96cf4e8ffa411ae6ac17a8ca96da91a4d06ea89e116ab11d3ade686a0e6e958ff3f1abe9d129c79756f1912afc77e85c7c29bb67026b7ede5f79dfd0fd12d64fe6e92face9bf4f43cc06893e178e5e2af84825ff7ca3df236e886f24b3b551de30ac1b02dce77ccee570263343bc8032cdd725c2dfe5330561eb17bf89ff84573a21d878cef78a117696567dbc9f80dce1c3b915b7faa3f3fd46625ee8b9e8df482be8c3ebe43652659ad109c69629cd080a64dbca71ef569e5be734c2cecc49673c7ce3cf4c46776fff4fd016ca7dd3c6a573a9929ef01b877ce3b933fc26001c9dc5ea2926d870096dd0f9b3364e4ace39f63dfafb3594d959dbf8124f00fb28c4cddba1b674e50a1ca35e31041a84fbcdd1597fc41f02b6f018e205c791b6a37473fa4fe9dc7268d6ed70883163949e409ea5fb8fc83770b618faf10d7c9684a6c681116911b57f477d9fb56f57ccb97e32e91a834d17e6a576dcd851a69ea11f13556985d4ceb532598162a595626c34b4c122f3c3c049691179455b1882e7b9e73e85e2394ecf77b84fa9ca0b8c719d03fd3fb67faa5dcf7b95dbf597546af6d384831d63bd5388a3bc250ed6095b8dc104649bf67a2eb737eae3534ce5478fc44ec0da41cc30f2e3c34b2a89eae3c11bbe25de7c926c7219241b9bda8ff01e15c0b1437ea98c535317edb956e3da53bba40fb13d9d935313e99d2ef407269c4ed5cad6de2bac17d32f21a697ae81949a10171c362b6d09e6055b42ffbcefabfc98a7adf25e7d7d031997bbc395add1a5bb611762382bbeb9295eb4fa3bd1d63b27122778f53b1a6fab157cc9b7e8beb9ee89e721c30d8ecffc4c0e07bfcad95f980f04d7709aaddd8e2bce5f88172ed0cff9bdb5f2773527d28297aab1343e66e3624c2c8d652305ea0bfefa77edaf059df06374501abf0cb7a9238fdf7ff4bb8bf32dd2dd87e8a07096766b7d39aaf5cdec2c687a78bf82c4f565fb25d3f1bbbdc0453facc468e6a437e87e039b9e1cb88308bf85b3b7c4efb97391edd1e8f99c17b031eb5b3606f28c19da9e8ec1cbe1e2feef72e73ad32bcf75eeb21c58bcf7e7a96fb55629b6be64a7cdf452949998ddda29c56f50dc149e71769cc2f34b3263e57833e960ea1db84fbc3427d2dea4b21bf2e1d9736653c5d888092f8341ac04b9bba451f9839e8554bf1e9f587c1e7e3bca640aeadfcffbe2efefbda4282f313d9fc6f981df0172123c7b7c5b7a1f0160a4f740d89d19a40d8c49a572cb8ea33d684f630c7318aed109b645de93d146cf0ecd3deb1bed1e93031dbf7a9db1cdecd9a3f41ec3f710ef8334e7ad39dbde716f843db1dc37c5f3da6e0c6bfdea75677193245e1f6315f3bb6fce811b432bdeddba3016c6e85c188bc8af29b39b647e3e7e8d46e08374e83e21b6ee695c8d5f7807fe1bf4137b962e86781f97e4d429f4497183f7aefa65dca5d56bcfdeab0af8164744aea26b86b648587f22c31c9cb075b01bc3854dee07da338277d4cdfba44dac2ac1ee8c6e38bb25cdc38167d9b40b710cad13bb975cf05b4443dbd26a3b1a1338cac67e64e71c9ea7247eaf8ef762f68ed04954f9a9f53a9176ae7e4c0a7258376fe3a4e2c19d0dd7fd7338329d127d0dc0b3ff4dbd03cf97c982ed1bf24d6e8b2ce19ae6e850b27384c7af6ca3efbf40333b83c8c549717e199d222d0e20fe62a6c62f3c9d4f733ecdfa42b91e620f46ebfe25ba06fc9ae18397e981bab662bec59cbf8d0ae71c5b8b09d06eba2030d1d8738623fededcb96ecbe67aac5fe0ab78cf8fe816c0fb8e81198d60ed4e06c4ea6a10677f6687217044bdc07cacec3385f87fcaeb3d5bd142177c7ba57b79976025bcacbd516535725289c4e82e2bc7a1f1bbf59379a8eabf144fc3f74ffb1bd1d801d4d1408624ba17cafb6043cffd0914cf0db3e0e70398c6995e8fb9bb76845fb56f9faf409e539f16f47152735d05e7c8d9c44aba0dc305e48f110387c63365707751cea1f202377f7fe5e5f16a97e536363f947d411e0f47ecaca13227c862b86e07e04716a12b1813e0a0f27b1e4f7759febea2fb44bc8cc9c7945dec97c8e7e44c786273c3f6743d735d91d8471d4e9f71a8ccc962d6c7907789e41baab4e5503c5dd24fb27e55bf1df67c8a2f8cef9f7aaf8817b10ef7e27abe91c91a306f726e8a086fc68b1f1f3cd5a7f425b7bd17bfa8339dd3aaf4f09bfa102bc6e4d6b2785ff5ccaf24f77ecfea0f97f40fb24ed99a947c8d701ec8b92f93c2b1c37b05727b93cd4d56bd3efcd1bda5caed355d03ec7f1092bb016c6d5d5d4c2c887f51b495eab7ef5579e0fbbda6af3eaebdaaeffa93c25831b583b7500738bb2f4af3c5406c17bb1f92eb5927b5ebae215e93f2219c97da751718d380760e2906b992d1b373a8d4059e6da1e7cf27195f2772d8443ac3a5a3c0ddc229d9c732f81be13b7e2f10f9ee9a5f8da7134e9729c607bcd3075db3f2fd9cf2f9c0e4e07b55eebdd88d913725f77010372acbdb53ecab707fa6d266aa2cf6206721fd32dd0cf5b995f79ab67dd56fff28f95ccabcb30467dbd7844e4cfdc7840f307c815d166411e0759ccf85dade5e587e3ce6c3bd85979673a0bd8ba78c2e2fea7267727295eca01563d8e89e3a50dd2bd33d4106dd5aba5877150df36e30daa33e52c9d649ae87c1a47dec4fda7bf5d138107e5094fbf9bb3bb61eecdcd93880580c552ec5cbad8e0b23d492b37351ee4de9bc9e4d5d8c5cc5f3587c875b9c4b76cf087262c258409fecdc9ae806950d519fc358418cb5ebd23bdb32d83345c8c7c9ed7b0a9b97e9dfa8af309e93dd4fe76480324ceffb02db676bf646724bdcf0ed79acf28de3e7fe062a5754e2e2467bcc053917e34ab31c4d939c5f166c2c99be087ad9f644e98cf232ea9b461a157d63360cfa793f32fc66f1c3677b31d4765406a03e18b24e4c572dc643976443760f8dea46d0e780e4958933596105bfc72cdef5ccc66cea22ddf78c271c4fdc1a14629f2ff1119ee7bb7cde1a7f75359fcdf97c7a811d529bec32e164b1ab71cf67735297673859bab361cd166adcba94e3933f08cbbbdf57e1066384e3ea7c391fc6cd0be4ce790f37efe710c1bb915b4769ed217f5699af51d9c3277720a5912db4922c6e8dd03be6d899c2f78f1775491f75d976a53cc1f45cd2463e784ea69f1f4f032a7fbd157418c8f5d1599932ef6bc23ec91ab2f857dec73481b6a00b8cd16f8d678db2e07448a24bfd2ff13595651fb2f69d6d73a66dc519c529b38de4f24ef39cce22d0c1f33826b6ef889d626ccf3b75f077eddc6e70c87523d0cfd5f892efab7f02fa9552a63fc33d7cea47cc7408c27388fd446f2c5cbd81f67111fc91286bd15c402ac7834a7b74037b4c55549fea5c726e53f8d0383b73a6d59cb093bc3f56938df5927df3f1f15690c3c17a7ffd566cfdc6903f4161bed6eb7832c363ec74a5c094df9b8b9958682f1fb13929b6121c1ce502ae8af6b818e2e75d88eb4d1795b4d79fbc337ed69f96cd93f10dd0a7891df1b90c03efbf64eb59c807a9768f0fd406fb0a713dfdc905da9713e0f19e93aac4cefa642686ee105f78c65382b064274379ce984827a7bbf2988e9def4f3c2bca7e0dd4c959cec47770bece701e1e17660a312687cca6056b407319405c7b6cfbd21af64fee3b68331b428bc9a35361b177536a3bc6f5d5524e56da3b3057d02d89fec8e74b28cc2397138a3e218a833ce764c6dfd8ba8b05df1bbb477c29175181cf55e4abbce6f7cd7cb7ddabfdb0dc9a57ec421f832187fdafac3bcafef97ad33c55649d97f47e70d53adf9447aa38b7c23de8abfe571a5f7a1effe7abefac7df15ef36d6b7e052e4eb7459bbffc819c5b54dfe6f36d5cf0c1a13e507847f73197f733d385189fe3ef22b3fbd4b7e1e1e2dde4cb38b9ec8fe7ee260fdf203e96e965efaffb8d70147010b7b2fb66bc6f84f16a5f42ffd6055d8cb48133ab31aaf0a973f9099fb0cd45fa73426d8539692aec2d54fee772115e6c73ee1b3bf3c7535f37cbeb349116e08b03fd98e472a43e392abbb239511cd13ce267f950d9fd844cdeeccbbdc49a501f10fae15667b1fcd97ad5b7f16c227239a07838b3338cda8ae9fdc589c49f9be08f829c33be037195e4bed6c2095b75f0bfc27eb166aa87f9a546ebf3f398fafe9c2bf6f3fc8c3cc615765e5bf5db9b7cad497f5c1e2f88edf267b91db12a46e7a40b20439664032e1f473f979d12ab6c2f9eb4fd99aca2bde7e2ba4ec03fd47354ff0638c2335fa5a756d9c0dff1b1be4323270762557d96efa6ed55f4c3e49e67d8878310f42ecfa7f2d536d7d50e344f0dccabed9df971e5f27a49efcd97830970de01d972673e0dc186bd7ad7974bfd5c6f7a27b5d839caf0924a0d4b79f00c8839ef52bbee55db78b0a3cf890dfb2a0da3cd386674ea085aea3ef502d8d3e66451b567f16ecd5b0831259eafbef49d55aa421e05a031b8a37a72e99c07a118ccbbe3374bef25c097f3f5cef4e1a28f9ff969f500fe1f183adce1d5588eb682ef8ad26a8de66b65be0ac8ad0471112bfebc522bf2c59ee3e798ebb5f959f3ee984e7836569647f66f8d41739f15f6103ed3d0efb83350af1157238cd91cad6d6555a42d6a4bacb25bbf814f1d63a2e81ce0ff35bc0774e9cc80bb3a3ba08fb1de595af222b105b783b98fb978f59cf7b7a269435ad867b9f4e97932cdce11c80f7b30f466be2f01963a3e2bea328f35529b027d86ab9d39335157019e093a4e3f953898c85ab2f5203226cc91f80806a126989cbf0bf5c90ee843534ee62cc2523a97329ca8f2f9b8aa9ce30cec52d46febe57a6bdb2fb629f481768db33e64efda37b5f94c0acadff427059f9fa7ca8bf36fd81a10fddbcfe68eedc1f7a7a23f1bf67281ef787cbfe02f2dc00336ac80fa907998f00f7d4ac027897fa866295a0cfa01f894cafdaa72fb872aabfea53d544513ec5ca4774030f74c69bf02ac6f70ae549cd3ecee4800bcca0658e5a4407bd4970c7114d4afec822d0f621b321b53a62b90f3bbb036983741a6324498d5b57864b6a05be0e4f154051bffbe9792b816f0a33aa914413e0376e7037d6793b6df4bdb9b4b7d9236957419a97e7b5dfeb6b8d786e047da192c9f39c14ddd0e8f31e4ac30f591af3e3e78eaaeaf46be5aa6a32b7ee6b6a7faab3f58ef2cb6e1d6bdfe0eeed15e8bb452a0e3e587e9157d8e90afe6665ae5d799c4dda20c89f1abb28439fb0cd091304706e824e318e44cc89b89311b7066e6676f917ea9fcc0ea010d22f06bc35c47eb9cbfd3bb16935545bc6f0f7c5053bb0677769bd91d89f7ef08f3777edfbfa3cdfbde3f763ffbfc2e66f9bc045eef849da5fb64a6b650e7ee102ff21cca32d8ae5a9d79779c1a7a1e6340ece3e6c289302e916b0f77777b27b7db8bedd0593f4f563b980fe607f20dbf5f15bf08b91a1f13cf9ab41f689f3b1bf0d718af5f52292ed8fae1bd0ff9648214d6d30d3b4cbe00bf27e63479e5742e2a1f2eac999af549e215db299b4b7ed7661cdb5c7c3ea3116a330b0c01f2766b93c974b8b767d2dec67db74058f493e4f6a9de54b55e344f318b2f07990eec23da74153cda421deea6c35e593b4a277682e4389bac4a774ef8f87482e34bf20f9f6f9a8d4ff095d5aa3acf8b4df042f3b3e7b9b5b3b679fe64e63bcffb55f2fbff5c2e068c657de5ce6853179760dfd2b45e6f04791694d60ebee9931c0871ce7bd59083a1057a255d8bc00e2fae1b6b4f632eb35c63b0dfe0ae3fd8e8d7c66cb806ba31f335c2bd8d85893750b23c2be09997e7c4ea9eefd7f3fc759fcc37fbf9e6eed77fdff9d1ef35fcebceb7961f24f05f6878f7eb8eb6f97e97f8a7f9ddaf66adf5e3fb5db876e777bf847af367f3a159177fe2937f61bde15f77424d68fea3d6fc87507bab8bbfeaf55fb586090555937fb950b9941431857adfbffefbee71bebffbf543ac09cdef776ab4befb55afd79bf51fb5ef77c3c08f5677bf04ac983abffb55fff1d06a7cbf9bfaeeddafdaf73b85fe3bfbd7bf62cbade1ffc72ef456fb7e37e1409582150fb914ac9d5572f7ebe1fb5d7beb8730d1c9dcb9fb55ffd912ea0f0fad260c9dc013b1d1f8f1d06c0acdfff97ef75c6c5a6ffc10c4871f0dd6b4f63fdfefe4ebbd357fc2af86f0e37fbedfcdfef5af5db44be6eeddafffaff6bdf6bdf67fff0730b620a8a82cef4aaada7ea4622ca585100ac77ebf7b5c3b8444deac8d37df660378eb7bdbaff87abc5e6fcfa178b6b6ce022aebfe138af14eb6563067f8c41fe3b90515767fddd93b3f70ff8ffaf87f423f09f1a3ef775cdd5ecbd9faeb68b3de6de79b7f624d6cebf78afe27f6c97fecb5b571012ef2d359585bfabf60c79eada3641e25bb84fc747773dac4b342da64e127dbf526a53fd6096d10ac6d9b3e840522ff8bad5d423f8be79bd04f127f1dd1bee38595bd5b797397fc77338f038bf6b3590773da78b35e87e47fc97ce3b3a7c91c3ba43fd6ce6abefd97bfa63fb7d69635dbae377424d84f747d76914b9b66358ae9cff5c6850ad0092d815c51fe9816b5a6f58be9af646109e20feef7cede228eb362c98bf9b1aa76726079d52594d7de3a08e6f37b6ffd0f0aa0bf2eb7392fb3ccbd0eadcdcab6b6f3e43e5e79f3cdd597d004a00ae761b15de26f7671328fee83b5b7d925d70b3973359ccf4bc09f577ca775dedf2fdb5e2abcfe7fbfdf3dce638293dd6f5c950c3721149276d661bc9927c9fd6f5acf3d7be09d7cd220da5a7e34dfdc077eb2c50611d4c9cef09723d2e271eef8317095ecb7cbbf74132bff3177dc45e157e1a52b8862bdc53d08023fdefa4efee4b71f27f5662d7fb058b9bfb95fa1c5355ec4ab79fe2babb66eaf377ee45d7c716fdbfe95b749e54b671d255b2bda5294945fcfa3ed661da7f7fbfa3f6bffac5534389b57f94d71c1abdede7b4e78ad05ad477fe9bded7ba488f7a506ce62eeacaebc7737b677e57511f355af13ebdafb326d54b438581b37f948b3fbdffe3cb836e722759dbf2e90dbd9eb30b83ea73058cdafa12cf293edfcda00a4c1fd6fdfda5e69b5b90a4499435735685c7f2dd6856b0d18cbbfd4601b24573b80f75720702c6771a57b771e27f7c007d71b77be79a79d13efde69e1adddb9bdbb42e8d8ea021ba04d165672652baca320ad78eb877150f1b878dc161ed3c3a8fc2a4993e247a12b723f8a345b22d1e2871ba7c9fde03f4b1656bdf0ab4062458a2a1350995eb601c7b6b64172b660850647b1c6ed7ef8751faf7c10315c6b6bd95632bf4ffe2b28fdbc77373e6828a5a76c204e3ee145152b89eafc6ff8ae21949ffc68169ef891b549df977f0abfb3195f7c81cd7e0796975c6fb28eb7efb438f89b8278065fe10b2a07145fec0bd38d919bcd379bf526b92ac3d9bbdfbfad607dbf986fe6e577bf37c9fd6ee7bbe5e7443bb8dfcc5ddf5be33fc99f8886556deee791e747f30f36beffbd01dde7439fc4d6b93cfafe3769b0b6dc8f7d44b5808f7db4dd58510272fd1f7e761faf83806c923ffa1c1856f2a71f5f90f62f74105b9b64bef93cbde19cb2cb2db25d175a7172bd2951426e69733f0fedb97b53cb3395e642bb64ebae93f7941f6f1d5891f7cff5c6bb3fde47f36d3e3bc059658b78b33ea6e517499adcef2264d7f490847fee9d8d838c75b10d03facffd761ec6548dc906b36cbff033b122feb7ed2773675b78926ee756e0951f3151217be82c2c67613dd0e32f7fbcdecf379637bfdf6c9df5bef026def13f997e17f845807f875baae6658fbcb5b57116c5274ce4283f4a8acfe6c778bef1c379b4bdfb7ee7ffffecbd5b73e2c8b72ff85df6ebc4ec96eca2ffa513310f4828419211e4652db05e260cb8853b135bffc236b822e6bb4ffc5212be94abbbf69cde11e7c4f060a7d025959775cdfc2dadd7f30fefeedb7d1895fbdbc7c76f37eb77ed7ad87b29fef61478fbddef6f0fe8d5b7dbf5c3b77783f2b1ae6fb77fb8dbf5e3c7ae7f7bba8795f4dbcde3c3ee6efdd99575fdede1a9f9eccaedf1ee71fbf0603fbb567f5a57bdfe6dbfbeb9ffec52c77f9f9c7fdc7e76be69be3dfcf19bbb59ddbacf2e63c5f1f3d3eb1be77e7377f74fc7b737ec6ffeb8fd76f7f0eed4dd7ded6eff7077f5f61d69bc2e05bc3d8535818f83db2d10bcfbfd78bb7f5f5bd7a2dbe3edfaf6fef9b34b1d2b9ecea30af7f08e123de9400caf6f9f2fde5e78ba47cfb6b7371d2bf91e3efcf6c7c7b592bb477fbdadd63dd427d1e1d751fcd4743381e2b7d637ef0e1ff71f165b4ec7bff9c6ec5a331cc56fbb27f778d7dc7866f327fefdf4f078bb69bedddd3fdeacdcedcf966ab68f8f8dffdd33c9e9e49b86fe70eeb79bfdfaeeeed32bf875f1d32beb87ddeee1fea797f77f3c77d7ee6f1feffa36c2366bbe3d3c3efcb8bcf4b0f713dc2d347dbadee4d9f3edca53bb0405a279bc01ad7634fc7af4dbda2f0aeeddddfa76ff4b4b55285e59bda32fac3dde1e1fdf8af277bf5b2dfde342d7e9e8b7a7c73fc2dfdffffedafefcf7537b1f881007ad45f97c7bbf79f8f6db3b9dd3b90aad88bf087eedaee6c1bd8497c1e06feef655c3f9fcd5fb7aa7e22f6e3ed143bfa2f32bf7fe4d7b41349bfbfd6f9bfbfdee76bfbfa97fd6e01355e25ffdf4b8ff95fb7a55ff57375efcb68571fc1777dd6dee6f7e72196643ebba7f76d5d3d4fe76fdf4edf6b7d5dde6eedb93fb59f7fcadde9efde3e1dbeeaf6eea290e15feca7df76d7d87db1b8bf57373bb7f7cb35381a5fcff1bd2b55b5ec7e54f96d8df395f9fbb563f9ab07fb130fdb99c6bf5c4c3fe1d1fa371ad690f01802e4cbd4386ada4ffca7ed1141b45dd66cea75b50e387e9c3e6c3e9dfea87ff6c1727c70f7cfb0dfb1bfff13ffe23fccff08bdf83f492ecb441f95fd9b6eab726ff9fd6f3ff8fff71ffe4dc3fb8e7f9dbfa69fff8b0fb3f0f0fdf36fbff7c3c3efef526e80f779fb6437fbfbce8b743bf5cfefe5fd907fde3c6edff7623343c6d8486fd46e8e5e545f0e5bfb611ea1bf9938dd0f0f7bfdd09fd7a31c04ee897f01fda096df75583f34ee87927f4bc137ade093def849e7742cf3ba1e79dd0f34ee87927f4bc137ade093def849e7742cf3ba1e79dd0f34ee87927f4bc137ade093def849e7742cf3ba1e79dd0f34ee87927f4ffb73ba13f6e589eb646ff43523953b28965988bc48a54a53c53824b1cb365cda933b8ae391f9115631ee2b89ce29c498fb90a0499302b241da54e45a258e50b4b85a450207055e23efbe8efa7549016b6901c4b930aa680ba63369279945821293de63210a4299a255668196c6345d1d4049b9c425948ce730ad55c86798cb6900d70ae94a4e2c40a63521684f3a1d22618c4cc7846652665421b383dc6c6a99c0e4dcc34a0c48a8509ca58a55f0b6945b2d0a1d1ac487186df231de47383fe5831496c1eab90050b25706c5289f353934673533789a4416c6c8a7333cd655f8751847b0ffdf15c729ed2a1492497578a5522e938457d3cc439bc3baf129b0b4965d69603c3a998ea4393285209d3ba909605d5f8cda4d368a1e80bce4d518726b4dd8d16e4e729d1fe7e77d51e1fa7d2463159b4dd6985fb39279daa7881ebecfb3695545e5110e69cd685b447cca140dd26e55806fe5c6e7888724e613937b2498c604122cf8d43dd47ee4bb4d30418970873969800ed8b120a8e02ef3781427fe7c6b73b9a19cbe47f077bfc9eb76d88969c3ab46dbec033a12da4cb63edc726d2783f5931437d64c5027d25ebd253095a73f95c06e5dca4fed9b90e7896d85c9b202a5132795a4fc8f14c8106649330e58949f12ca74ae0391e2bccf901d78e496273367680f633e694d916321089c67c0462ac58810fe2c496b1461b023195214f25ab39d5cd485228297078d748b6cfcc5a5a2e636315e66f2469902e50722c709e5247928e390501eed7fe9c55890904e673243927136cb4e4386b9f93850cf0c7b949dd4c1d9a91f234570ae31493439bb7b106bd06dbd8a4fbb6e4383701dab48dc96e406f230dfeb2472149f5bfc7892d33c5a569cb35eecf65f0288cbfee4af441a76e267d198d4c708c19631a6c992c68bcccc99618bf91494595d872aaa942bd531388abb62caf3888b4f6f7444bd46d04633ce62d9f9473498742061178dfb7cd04684b34c59898e03855b4f1f71baae604da0ca29204b77c1a44908323130c0c8b3c97613b3f261890a6013361aca31964229de453d9d26810cd09ef08f314f593554c36423fe6eccff344fbd2b76729db6b5ab283dc1891e5d8c86644d619d443d691a6b295a3613ee7433322c7b9979761ce9e4fc29cbd3c0c79acea66c49c9324873631a76ee469366c62aa9b54922c542a340f9b54f2669658cfeba92296895542591ee19af2f2508126bcac55291b7d68524da5efaff47249e58663d065aa3927d4a3b92c250d08ef54a0cda02e54ea161857954623f06762d55c1d9ad4049bcc840c3e4b3127386fc8cd4cc0c61f87fe19b443b7fd515af2ba50027230f025855394b9b17b5fd2b049c9714907940e739f32955789550c19a504cfd00fe69212cb1813019e5094cf8db385a26362c2354a2143858f5ee42cfc7941e936861cc331db56d7293a8e13cb53231b61ec26877e50349898d4cb1f41763337362a354d0bc5e54812e81d63808f39f095948d60cee784f1e26a6e0477f4c4d79cf28c024f1762419c908d9689ad625337634983d1423663e9fb5189be34b6142899546c52678c6cc69a546a50bf7513433ce321ce0d267e4eacd30af7304438ca32c3b5c456f902f7717393d86a2a69234d7044dd53498399a243a1ec31a6603bd5b4c9f0ac49a3913ef892281025ee35a197156323f0fe2313e8c11e197ad39f0fd685b2516ef07c1091d7a1380e73a1538e55770c5e4b2c7855cdfaf741c6cb800ae57221036f578cc972ae822dc644a31fedb96882df2604bd6f62931ec1a313d825f84dd0e5a8073233851ed8c41444534d0391d88d30b2999cc62b703315b0967827782970da0c1bccb7214fb79bb9d787753331c1314d30ff814a1644850a73c871296533212ba46a4b43075f9266c50af314e6e0c9095986dc9c30399298ab10b6d8864d6743e854e4543799f434b8ed7544061dc1619e732bdff17b92d86d4c22ae50f2b0c924ab911e3699229142662ae98f211732456ad2fe86fd15494e31f65b8c43a60936c136870da331df01a3ceb9215e9a30bff2c7765fe834921494734e218fb77372a0cbed1c724ba711e198b93d87b2b30732d8335a70a949e5ca7afecd980613e8252d58ab1434b865c86c2d601bb891928d97432640dbdcccd44d6e9c60cd25c600fc931b07ddfba5d034804d35532c71bcd0ace2fe1eb2d10c7caa1974660bcd5595d826c69c682b2474b2867c76d3425b9e1a615152629b5c1d9a023a46db88c90e62336c0a08e5c43e8a856cae606b817e74e060bf6ac82f0d5a3a34571a73e1e9ee31872ed4c14028d95c19ccff10a51bb7bfdd440b59e8208a4d9a162615e8fb54924a51b74945baa09cd5a1994a5ae3fa4ca5959f2b930a99d863ccb4c9da7290a28f26154bff3bf882fb97c686730e30dfd0ebfbc2a43cc171c7af53c515da049d2c285415e48a499de725c8461ca38d6da98ca41c7338d59ed78ede8630a9bb81dd9bd823f4c0d4c0e6e1f63ee801cd31f8736ac24a50c0b013a72674b111b00d8f6cbc9dd096d246d7893d56f02fe8d094a0e5966fc35806db394aed657a5d1812a5a188f4b029258356c398dc14e78d0c4093610c5d6748900cb606ba53a34e6f0787310ba55bdb26f47ac7105f6b2af18e5c89920dfc9cba29bbf7c337f1769ea128559ccf121bcee12718828d19ce4dab7b4b130c4457e6ad6e08e7c6b53e83a1680e1b1ba5a99bd284c3a2ef83815d62cb4a7ab91cce99069dde0f3bdeeae73264356c4ae632970794e8cf2066f0936c668a36734e9db7c34c2b3f13e83663a1329b996226f0466207b922f0eca093b16d6948f87a4c886b1174ea5cba5290abbcae5bc866ae6c9543569bc04d13ebedb0b911aea4d4419fcd29158a890a13c6c278591f69691d2536d22a10b3bed4dce01ddccaa488599439f8d38455cfc773e88aae6f52929b262e866f40beb4a2943c2d2815f06b04ce19a70c4a429d6f4bbbc77ddafb24f8edba322c21ebd2f658e9856ca4b7a55c2c20a709bcecd08f127a4d2ac8633ab291fe18fe26ca199e531c4bcc33de4d413483dd4a29e46b644c1867ed35c854f225a7117485d4c3466a12337340597ab945a99b74efcea1e3d85f3bc2ee949a0690f72827febadb648a18639343f760ac4c2a7235f425da0f3d7fe54bd07f5b562418633595ec266423365616d4bdcb082e25e6cec57319781f519a80cbc4c5f36efce79017944625f96bbe2d7313a0cfd017adad8a63f676929f4f69c29c94853efe8afbe093e76cfd7da04f49d6cd64c0d0a3926c84b509f0eeac6bb736f00142e56d3712e097eddca4d1047e79e26286bea6600bde95dcf69949c4befdccb1c43c319719ee5db8063a5631090d3bd7f3ba13ac08e32e587b5fd9db7e70a650876ee92c8f55eae6be443bb086d0faacdede242b72e336b1a76be7ed04f8d15ab22dc872efbf6b457896611b50e272a1294c9873a33af98bf3f007dbbee7b9ac1bdddb7f645d8973b095c94653d3be6b6e82a0201bc10ed72664b4bd2d539198d0bf878d5d17f085d11faca35020d2c495b1c23c04626a60b3ba3236c25f9ba9d4fbe606b6880c8461e86d5cb76aa9e1a3b932862d08ff09fea7f6ed2d479e7f02f8b08cfae123ce0dea09406b4ac3eea4a0d49242ef8b5350b2b2d705f404e610baae3df6ed81ff023e44dd8230506e8a7b29716526a9eacae3d4cf41ebe7cecda1313a15f02f8df77187f88db51e3cbb850f0f3fcbe8d4490e617bee713e874d42c176aee81843d6e1b83fe7e5b02b33d0aaaf173e892b73c5254989df47f842ed7a922b73d3fad846f3b0a0e0d1cbe6b6f43683d1812d2838c64636fd3a97e9fc7963d26899b872eaed0f57969a15c67ba6886f14ee871fe1ca19d366d28e61e4eba4d69e309079b88f528e75c09afc35e76d0c0ae39c87f81dcd14fa1876fcebcb2ff8ededeec4959e2f7570f4fd20610b0a054bced13ff006fc10ac65e5d0c1d095c6aadcd359a8a043700d6b21bd1ec6dace487bdef5b6087ecbdedec03aa0a74b94a0499481587afe095bbf11b25a9128135792f7b3e9e8fd359cd7584f712531c1e7453de0e7920dd6722cfac546a7a86b13c38f4489f5460a3702bce6db13b812fe2bc6925bdb8e608f9820142d5fc327db179c8a6b2d1b522460f7e9c4292139869c26c811497eeea9f339bdec672fd79530d6eb0fd2a913aaf625fc59d222efeedbea6e8d81b4e0996aebf73a9253e77d3e6ed761b09681bf49021f5fa8b93fc731c1c6e0d4795983355f0a59244ecdbd8fe9d45cc3ff1d366482688af326549974b9f7eda0ef8d15a939346442d6c68ad2af25380539e87d6616907b5f7ce99f813fcf2513fa2972d8be8475326337d3ee58264e69efc73bc5b26e88298f13a718efc3f8999417b0f7615fb2d8c4a023161bd8e5c481238c3fd69c7d9f8483efc5c67209ddb520c81a96f0cffc38b576cf6b7d0164752f9bdbd2104fbb6bd789db0895b6b4cb01631d73a9e8e8f985832dee5f74c742d171d4f96d4bc80ff88d7ae88fb1bee2cb56566eb25626fa3e2ce13fe0d858559e8ee1d3d4cd123e7be23673935e171c787909fb043616ca32711b0d5dc701ecdaba58c05e1358c7c17836b1c1da87b5c5c282c61a41f66bb1b04ec226495ce3e96a61a3f93ff9b1c8dff095bd5f0d9bfa706f1f3475f1fbe5e57f67d0d4c53f1134d536f2fff3d723cf3153e798a973ccd43966ea1c33758e993ac74c9d63a6ce3153e798a973ccd43966ea1c33758e993ac74c9d63a6ce3153e798a973ccd43966ea1c33758e993ac74c9d63a6ce3153e798a973ccd43f1c33f561fff135624a891ca8ae0ef9266640160109a0d3887b9402a54e6b441ed968ae81f6c42eb6cde74010928db0131db3051a6d30d21e8db11540a7b2e06b456ade2254426982af403a0331891de02ba0948ddd18ec46036d01700b900d8a8785612e0ddb4203c5f3128f59364b452af5bbfd36cc8d8d48b27f6eda22ff396da386a26bec54eb342a3b549a8ff0e140e4dca1eddf464af48802e9f21c3be71ed1fae2917219b791145d84c3c6a3f28c8d7c848ab4d8e1cee385ff5da14f63204b315e788fef7fcad740ea192b3cf21cbbed1ea18c0820eca47b84e36b8402e31ca23e1cfbdd7e658f95dfbda63c5712e85c31cbd2689ca542cb03a297c448871d3aa68b2a41948647b13920b9f35cbdc488e4024a0def89550014a4d0185b204e95f4684f063a47a54054098c29b2e513eae203defbe877e9256d806603aa05e8b73122c580ca9434c865e8d18a86037f2d25cb86309687464b52b939bc469451ea9157eceba20d90321e4d4c81004ac6474a20420a685615889b16a156920973a0178126187944ca1011384eb651655f80a693403402d5a3c20e1596220ad0a34b737239a2600c226f14d02540c1f488ab14a8687e45753b9e69cca76ba32764dbafd2000d812819204f52a0d981c82a4979d46a8e680b44f86849a0bd68ea910d886812ca234610b987b945e40950712dfaa3ccbb289fd2843cf391611e3d51fa28144486795e43b41521d2e7284c18c7409ff848bbb42e54e0d1e7531f59900e3c92c323bddc666e02a0816244455c033d073a06f2ad8bf4f391271444dc23963d42997241b689810cf3482020b2405be911f3957979e0d17f5f3c5a8ed3b4d0a98f1e5c02010484bdeadabeb040cc961e85a1830150421de2a38bfa01bad0e61a487e4f17f41508c1dc209a07a89394e3c40a6d28d2af289e786ed2af407c7b541da2fd4cb8019ae73407cc65ecd143b6f2482720fca48e31273168c6f33d2102e4314e6c234c7aac947506c86445aa431a97d422bd31ef22d788fc0c6aa0ca635537b243beebc40e04870afc3122e7c023688b68d1808fec517d29109288e0dc0a8f7ef17354ea2e92412b42b415d07622938cb9059abecc20d774ca534340329625f8d1f3b38fd4db407e27ca476770cea9405b2a93023d58cd4d0ac4e2d7423b4ec11786dcb44349e5a01de980085382534436946d140fd04c2d3f0249997220b442442897c62396848f20d512f44bf4366a7402f9065e9594e33d4ba00e4d0af9ef74c7c340af6540c40295c7b83e6c12a6f20a32537568224947cd909d2903551e839610ad03de970c1a1540df2da057b447e429a0db817c04aa154861c8434f5f403b828fd8baab567fe453cd4d87e00f811004ed4b13aa4e2700ed897e1d59f114bc35f5730d390464ae47aa09f6d17040860e9ba9220179005d90abd4473a5e698c97cd73e8142d1ba1102984ba5287b65d01ed6bc2189176b32e926b2409d1c38894c985a2c129bacc477bb972aa3bb4b56f03680af05ef0a88d80960402f04a4b205c4ba329f5728daca7bd91a60a349d9800fcc87d64a14702b73229cc7d845ee0234e8d062f236ac7f9391288c4d442b0a447011907c4a92131418419e4afd71fc366caedf81b1d08c2f821ba5901459af2d2d36d8dc8d276ac0963d1ca16b407e366d006f08b6a11652990fb88e691147a9daeec710e149e06e2d847c872ac0f4d8f86ef91db04790b9ac13c81be607f74c8b5924922f20528378c41a6381f318e313ed0198866c0ebc81110ea8ad2425329baa8b831d9a88b2cc83156af48451b32e1fd40b422822a15234d90b7f19c036f4f69448800714796972640e493e70fa03505a23211f5eb51dcd06ba051d844407d42c78740fa55b149bd9e989dd0a52d3a77d6ce4d0e5e982a2e33a06fa515e41179368cc9a3027344a78217119d3b879d20c31eb92e34d9087a10a83aee22658d26449e0de692ea82832efa05d103d68f554ca0c7367213d10423035ddef254cce9d7828201de3df2edf351ba9e47461a118fa1f2110dd2eb2b446586731f8906f9e9235505fad94728e7404c43ff00a9ebd1b1297748bf13ea1df6e514f603593142ddca46401a57406a9b1036a7a7294474d440ab222206fa6521dba8a72e8ab694f59bc83817e70bd7c4892be7d28fab9b64698e083763523f2f39ec3c1ebe89b46ea36153c5402a9671271b34223611910f9b901c275dd4410cdd0f1d4101a29aa2980267125709d3da734082436fe604e4713290923b7d8768706f1bb411cf2a74a581ac822ee39eee94f0c8703a5e411f2b5b01a95a4016298c63107a24ac2682ec9f5290a2048f2d3ba42ca2c3210b26987fe8250a426f3fa934826d85fb40afd2783b13d1682837c258d8344048ef3b04fa710edb425a44482bbd008f207a3d543e62c4908f863845867588fa511b413a98c3c6ce52151bf2363bbe146034e6c0220ab68cb56c962618206a097e09da34ed50dd203e4d96a77d341fa27ffc57157c7478c4c6f988186152c65720667c8ab6ca5147dad6e1f554d9b757893cee10d3b05f417bb9b7d91050818841da4c217f8cb7571139e5a3eabccd82e75b14b684ee6ded33ab84112a7e8d4838b65f0e60459aa6ffd73f8a64f538bf3ff77f0d61ed6feab1abfffa1a0dfe3bb1ab97ff0476b56de4f983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff87ffee0fff983ffe70ffe9f3ff8ffbfcc07fffb9dc757d46ab23bba4d12efd62fc3289be46ebde466bda387fca2fa9ebfd86235e600e7af176a7fb318b86c14d4eb1d1f370bf752e9fa7ebde36d353e36ab1ddd17f2a1b87af95a9b71b4ad92f8ee7a59ba6cb2795eefd4f76cec9e36e3aff5f5e24b7dbdcc5fae975ddde37cbbbee0cbd5656e7d1bc4e326b9afb6ebbb7877b338bad54e3c567a1865c9fae9eab274ebfbcaadefa2eefe41703b91f7c9fd6bfbae76e54bb5104145781fbf543bf167a5b7ff4aee867596c4cdea3e0e37a387baba9f3e5d5fe6ee7aa91ca10fa20cd09795debe79afdd77cf05d5327fbc590cb62b3cbb13fbf505157f68fbb4baf8f2905fb4fdbf4a860fabb17baab4ade7e64b7d7bf1b66f83c366593e14ed58357f685b24775f9fb3f4f87cbd90b5bce4ef9b71f4f8f7e35586eb4bf5bc5a76f78d827a110645b573fb0aed5b503dd7c3fbabbbe87bb5cc2faa65f67cddd57dd53d7375afbe6c9275f166ee4e63a11703f47577bd285d31d9175932acd196f58483d91de864f0279edb8c59570b61e95261bc1f8b71d5acc6fc74bd085da10fed7313e5d6bbb059edc41ecf5e2f8edf2bd944851e3cafeeeafb76acd6fb2cd97ada2af4309adfc5ffeadbbbb9885eaac9f4693de1ef37fd3dcba0793b6ee6bedc6d5ee26e8c31bfea391b8ba76c2c5ed63b31e8e8fb697d515d76f5d5731daf96b2f92bfaf2f7ffef325e2b8cc165dfd6385c5fd41d1dbae8034fa69b05077f4f632aac96ea3d4fee5edb0b1990bf7c795e5ff0cb66c72f5717b85f9ee66b33d906f9a1015dd8b61da731c857f7e863b5bdbea087dbc3438d7edf2cc01bf2f76c5c7d3ff12f7b9e361b2f7b828737f4dd5c0ddbe7c0dfab857bba5e1cf0ec7635295d721f3457bbe8a948369e5ef29743ddf35c360afe8f6cf26831063fa5adfae1f9ea259eae2e84cd043f55637eb9be88c04775f74c7db31804ab9778bbba8bb7ebfb7c7b2b9b7db594f56631b0d532ff737559b9f5fdaff3e7bbe7eec2ff3d64dbe5dbb1c9ff46c695cf55473beb9778021a4a76e5fe7a597ecfd2cd76b560df87eb8be8697da95e368b32585d660fc5b2e3a7897ab8594e9f3663f7582df378b588c2d5bd849cbb98ea21e62bad16d576b33806d94435ab05bf64936abb9ab04bea9e56b6cdfa65d0b573187dd03bf51b1eb1af75610e65bd1e8b3f6f0eefea6975db28a837933cace4bb6b9ebe30effd58beebc3bbb91e4627baede6e266ecc003ad8cd73fbb3ef85eddaf21c73a19f1f0ee1d378b41b349ea6676787f7ebd638b7675eddcdd2c069627eed0e9719bbf58f403e3f9ad5af061fd1207eb9df8f3e6257eaeeee270b5abf637cbf8502d246c00ebc77accdfdfb72ddf6fbc0eea7f57cd6aa7e87619bb22d9dc614ef01e8cf7cd05bbf5648a31dc5e5fecebd5c5357868b71abbc37acc361bb7756dc66e5f2da74596c4919fcfb17bba59ca1fe863bd134fd5387ae409bfaceeea067dc77bf862eb5677f13de82e1bbbefd9b8e3a385dc6793d2adc6fce726895fd0afd565565fef8e61a563f0f8f7cd58bc6c9243ddd7bd9ad877effc388f6fdfb9196fddfa2e7e5edfc54fab4b09da71d918f44ef5cd587ccfc6b9ab165f20579eb2e46bbd1a47df3727d9e6e7e8f266317882ec81ccc85f86fdfc2c71fe6631b887ceb85e04efdad4f249f63a07f72d4fc845f8bc590c822cf9fa9c8912765f7033f6e3fb582db3e2c33c3e5f2f73ebeb4d4eefcd573b071e7ba8747cb7ba102fd5fbf63e55cb4de2cf27afe34fe3e8b0591cb71bffaeadcbc66dddefdf777cae3a5df3f17d370bb1bf59362e1bf3e37aa206d9f8f85cbde7c73f4157a0edd53def5768d324f0fdc1fbe5c5d681bedaf11f84ab45eee7e5234d6fc6b0ffe20076c11af27d27eb6accbd7c74d9d8edb249f9bc5af0f36a477edef08e6b5fffe3f266f1e5139afc659efbc7f9ebaf7909630c3baa7ca816e5b78e8eeacd857b5aedf8a57b6753dd0d1fbab67bfe989b20ca46c326eb6d9dd73978862df051269eda7dbf6956bb412bf3920deabaefde117df2aebb6e3ea3b91e1e0a1d773aee13be9b405fd0c7b6bce39b969fd7ddbc04af72c4f3c1e6013c787d21f6ab31dbab24bebc591cf7d9b87cb85e0cee2b1d6ffb79c82665009b2f9bc0c63ccd15eaf3328b21a7f4b6b91a3f34afb6cd755d24a29f3b4ff78b0bb7595c349babd7f93edd9f25716b4b78b9ecfbe7e9e87a176dd73bb5bae17075e382a7ee3a78720c9aea7832809ccdc6d1ae6d33d5c524775512f9f1bcba18b8cd52bd5c2f0ed0c7dbcdb86e4ee33af676f27e75b111ab7bf5720bdd33d9d7f9055f548b41907f7fa8f30bdf5e027fe42f9dcdfaf3bffb969fd6bff77dd878b9fc0bcfc1ce4ad6bfffb4effefa2fd4e3c7c8d733588fa9b3777efe975f789bc6b6fa637db229b349f091be5ad9271e9b253ffe7ba9dff0594fffba957d6a776cd6e081c5b1d3e1186bb7cb926d3b572d8ddf77f3710f5a6ff9d2edb2b1820df7904d36dbf54b7c7ac75512bbd5eebd3eb9060dc007dbc90f3cfc2b3cdab6a978374fc3686e823abff072e3be38e9929ff171fb57c11ef9fc5e4f83f92b9d777c8f77336cce9657dab96ae54cb279aa960c1b649fbf78196936172eb8bea8eb96962033e2d33d1fc7e96dbbfcdfe4f8b5a3851a6199efe9f2a7f53faf27f1f3fac205e02ff86eb08bc0033f7f9fad5ff919fd3b3e57013f6d26f9e06af796c67e945f7f7c949b5e2f9eecea533dc5ebdc75fecabaa5878eefab96ee7637cbfc3bec175c5bddc5fbd5c5ba5e5f6e9acdb87ce8ec8770bd1b7cbfeaece45e0eafc6d1bd5a0c82f5bd7daa60b32e146d96f9d349bfbd6de37d7979b3547fde0815ae775f1e0af9c91c7763fd4e57b6b6bba916eddcbfb38f96d3dacbf8ae5d7fc80f3a7da9826a111e60c3ac5e625b2de04fe42e1b97a0d7a74ce4cecfe1fdb4b7c3fb7734983ff4251b8b3dfcd26a623b7b2c7aaaf417c8f3537df202ba63ebed8b53df3bfeed6c75bcafa5d7f1205c8d0f6f6579378765afb37d3d1fc70463ddfbedb72fc3fb6a59b9d5bdbc9fdd79b9f0e76a2c5e2af275dc5ffd5caed6f9457bcfecae7d4f36095ee5fba41b8fbb3858dff76de8e72776b05ddfb4fba55afab9fce0c37cd09d69276ffcbc95ad6dd9ea1ed88707f877b019bccdd1e926bcafdab9a74a783e7c68f598eb7d58affbae2f4efedda13bbf5b5d66f0e3a1a31ac8afe9685fdfe8e1d76c44ffcac6f65bf1d2d781f9a99ed79e86b196f1001be0307b89ff9d8d0e7569f6f5cdddfedb073a7e6f7bbc97ed6fe474db872cb9de65bfa0d3fb31295ef63ff0775fae77dcd1627b2ffaf087f47e1b784357cb1fecf456d64fcacbebe5b0e7793f579e5fee3c3f78bb7e75a91e70bfd7c378dfe43d9f6e76d11eefbc5ee683b7b45d4caae7d5849fe0fbdc6a5bff48cf6f64d20f3602636ed19ebfa0d5382a3e8c4337f6c10dd608ee5eed9e8f3effeb9c9dfede8fd3223cc98c4e9ffc824ef3e3013ae96cf2575d7093b4b2a2a343cf27effbf2a36efb159fa434438775f5aaabff2a1986d3844ebe67ffce8f3eededfb77f867b3d16b9fba767a7a360b11dc248317d0cbea2278283cbf0c9f4a6d7f7cfe126d597fb08df3ed6aa79e578be3201b8befeb0b77bfba9fd6d71af21675777a702c9eaace7fbe7a89bd9e7febbbf8f588b4f5f98ae423af6fb7abddc655c93bdfefed1fe6e20aeb11d095d7f0554ef62d6c72f570bd9490df17f049ab053f65e34db3d9b9a76c9cb6767447635e1e74faf1d537eefac8fe3cc6c8db6d0c5ff3d4bfe8ee66e7d708829bb108b2f116f6d8369b74cfeaf869b308ea62347c9cfe291bac3f5749bc05ff63bd09fb06f07d57175f7a9bede566218b777ab1b3715b593980ecf1efbeda359babfbce267a6327faf949c3edcd45ef5357cdfabe0c4eeb19e3de7fa4d7f9ea7cf5d731ef79d8f7fd6975cf8f853e8dbb1f07b95027dfa2f38b7b7aaa717f96d4872b9305857e953baff5639dbd5b836a65f79fab8be3f33bfe065ffeb93fbdb3d72b6d5fe0938658af795e8da3ce9efd2b5d905ece5ee2a678d937b79dbd081aeb7cdefbbe9e6c945e76eb84df8b91adab5df4d2f9bfc7ab64f8a5d51fadad37bdebd67bbc8cc2dcbcb6fdc49ba85fb7eb1dfddaca690ec669bddaf1e51b5a79ef0b4c360ff89d25db3f6f4037176caf76eee91a76c798bfbf9f1bfb0b6de87c873773d6d3ebcaafa563fda45d777a9da743ab933dcd0edce6cd1cfbf7c98ff2bfd379976ddbe0977574fb565fd6d717d11e73053aa20beed78d7a3a6a69a193d55912bff6bfb385ba3ac15bddd8fe6334d9bdb397f3e2d0d7079bf244271d1de0f9d58ebf6c92785b8d95ab920f73d8da8327dafea0635e69fea3bfd4f93dfd38fded5a45676f5debf7fdbdc6bacb18f6ddc6cfeb34095eca61ff4cf9bcc29ed6bd849cd8622f6cb308ef5abbe02b64c39f2bf8d363f7e4d736c691979be5b20e3b39e5e55a99d887691204addefefa7cb2afeffc3ec8f3f5ae71d758e3ec7426c6aca7cd376b6edbb7f6e2ab6c6cf5c4475aee759d97dba8036b126908f9f754ed8ecfd823f844c7fd3b1b65616f5fb4cf5aac9795f0a5b1e682fe166d7dcfd9883a1bb9d5473798a7f69acb46547cb09dff85f1be4ae2e62a89fffd834fd2edb99c787ec45fca7e9d34f16be8a779ebf692a06fdfad997ed0efddfd61eb7b25a7b54279e3f5e08fb2e58dadf28e576123f87bd3765fb448ecbf7ebaa6dbfa54777eed6b94dbd35eea2fdbe2b6bebd7cafd76e38c01c3c55cb752ddbbdc68762d9adabb47585eb0bb6fc768f56ff4cf773eb579cc6f054c76e857d29d1eedbbce171f27c3d697105e0b56bddee1967e328dcb473d3dacc638735f8e2473fc2f67e4473f223f47b3fc2eb9164fb4a47a3e1bfbcff910c43f81f5e2f75f3dbd78fbd3fdfe7e455a6b7b451ae3adadeadc6d1f3fa6edbd26f6faf787df3d0d349bfff060cc03dd6f596fa3d9d9c78b1dbd3aaee734f2fe8f37a1c3daf860f1f74f0498774e3e4bebff5975f6d9956175c25f1a17d9778eafc8ed3b864497c79e365f9f061b32c5d25babdeab77aeedd5858e8effa2427939eee5ee7f6d57ec36ff57d11fc825c7837bef0bbf9a9ba540f05bfaeefbd5bb380ce7fdbde4e2f55bb285cedd412e352b4e31c57e34eae75e30bd9dafe6e69b5a3ab53bdd9e4237ff8b54dd0fdbffe4ad7bdb5454fbacddb8e9d3dd9ffb5edf8fd6d5bfb7df3fecfaf114d62578d9a761fb1c721f47fed5ad9eff9ebbaf1abee7ae3bbbd594788decb1eecd3a937fb415f9f4fb20a3e456fab9efc5bac179fb01cfdfe0be6157b2da7bda677efb8cfdd5bde79bbb6d0f934ed7b051faab1f06b7818b357bd19edb2316ffd9a1f7cd5e5f4bd5c39cd13f4f24fded3ebfa37fa60b38c2df4a9e76d113faf2e0eaf7b43adbc3bac2ea27d8f0f786347f4727bbbbea0269bf8674117fefeab5df9bcda95db4db2f57bd05d5b9b4ff650fc3e74d13d87f7783e4f067fae2e067f5e2fe5433561d80c9eb60bf989cffc6eafda76cf630c7c9b9eb05f7f7d218262f21916c07ed2a70ebba4ffa2ae0ff8a6cfdae5f12e2f7f5507f6c737efdbd1f7e955bff871f9b0de92568b3cac2eb0c6bdaed7137e598f81d3c07e597c58efdcd3277eec2fe8c3fe5e8c07ec31d897e4d7fd8a4fe4c0549cf677be654956ff910cefb2e455cf7cfcfb71dd6a7f3cd9e1c9beceeee2afa8a7383cfcf06cc7bf3fae657dcf4f7e67f132bccb26873abb1b7e4bee3e7bfe7379360dffa7fb11f4fd28e4fb75e03f4eb8a6935ff178bd80cf3fc0ded6bfd72f718fafa96f2e06cf1b2f2ba1c38063a2ba5a6e9bf5a5fa9eecc2e76ac2fb4a0fdc2dd6f9ef5bcc8eba108f95d9ffb35f87b9dfff71f8d53c871feeedbf157379f935fceffc56cc977fe25b316d23ff27f21c46e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54ccf95331e74fc59c3f1573fe54cc3ff9a9980ffb8faf5f8c91847c456a6e28923a7553e44692748ca515a5d471664259531a218f0e25c865c8e595a42f35a54e2a3ae6c85de6f3d70d9bd8583c3f98203f95a40172e9c494b2d1a9c3b39a02ce4c807c3b02394c620acbd7df41c83e878b15cce9d75a713ee7d48ddadf91201b21bf07726a258a8eb1b13e4f4cb2d07122bb9c49ddef82c9e7ef4b241da759ba8995cfb1e78fb5e4aa4a6c1e9bf450ebc08d9083eaf5fa3197c1a3cf11e7737725b1913e675a7b3f85fcee7e422e1f7f3c9848e4d0c331e702f96b74776c84ad4dca1389fc8556684503e4d5423e16e4758a91d74c221f4d2ab44abf76c7aecf3f94f0a14994284be465933440be9a44919829e484c439e4e2bb8b47c8bbd6e647399d434e2c7f0f852a97746cf3f5b5b979a65dbf7c1e449f2ff1251e99e0f51cb579b0fa5c8957c67a1ad07468124deb9a5347ed719818e48eb44eabb4cf5de308b925db72dbe78e7afb8ea9cf41a3e358862a47ae1f89dc5556892c8d6659faf9757e890b1364fd716542d619de89fc3e38173473593789112c32b18995ad904b09bf4b4a1f73836bc1b1cf3196e01d6d8e23e42df363376feb8fe6d2a14fd19c85ad6520a66d3ead6869eee22eff971f7fcd34102cd06e31e2d421d753c2f478d5e59b62931ed3c5ebb1e0245e68cafbdf1d6fe46cecbad669e4dbcb34989c8eb924e4c3415e303cc3c2e7d5131a63ee9033713bf7f99402512a3ad49c460279380d6d72e437f3f91983a3cfc926295cea34423eca91e45c2a96b5619ef939aafb73aaf479ca9037aacbbf454138c7dc231f970990eb5090d2b1466e54e938a54333d29c83060bc5397228e56491afb0cc29f063826b8b8e6f6be4579314829647c6f71ff9dbd49cac33892da726ec695a9424a6c8ebc878866cc59ebe82087c37eae6716482a8ecf252b5ef0aa219da64c21839c562150c7229bbdf3edfe3b1fb9dcfba711e997033d19c231f23f22d96943a8c2372088e9842e4029df8df76909bd4cd5488bc53251be773f3691de07e15734a355981dcafa9447ea9ee5811cb4ca88caccf47e97f73bad52610e4731a59f5b66da9f6fca97293aa7962556eec66aa28471eb05c216f17da101c05f26391b3f8ad41ab7dde383a34a9e69c161a32cccfe74d62d55cbdc48685cf75989a6033d144988f0a34a4d268a20227defd0eedfbeba17bf3fc71a43c5d427fb899e49657551a61bc621679be1836a90990300e6d8e663ae02a137127ebd59c910f51c7a909069337bfa5f27c851c9e9e7e5272b6d6e063e45db42a469e3e4ee272a1e318f9a41495239dda425113cb90a7a7b69cf40843cfe5140485e212734f8965368e918f6d2c695d282b26cae777ad04783eb19530297412caa36f8b3f060f0e9bb1a2303698ef5030c6baa5914a185273239bb1268caf3f87f91304bd63f11ecc1debfe1ae433748eb2d19c9378ac58e514d4edefd4cb34e4d5239fd7eed08cd9d386e785ba95019edefd799fdbcf9df4943f87dc974a4c711eb92e6b9ff3d0f373c52add88b7bf391533c9799ed8f523853172924e9027374bbbb607a2ca442916c366a26890239f271d9a892631e3a497ef5ef6d47efc03a74d12cfcd5d4c261cb6bfc5b456c8e7a7e3a5a6639ad8cd5cb2423ec7890964ad52512267a6098ec85d5c53b09db36fef666e5ee28e4f37731328698248e279b23c910c3db9011f89b604cfb56d65cee73e779f95b5b651d21e2baf4f34c6076d0f2197b73159d51ffbdcc05a369909c2f9ab4db2edf39421f0fc0ab247a711656999b57cbb658cb3b2e0ed2d1b8ebd4da1693052c85769dd0c79252572cb3ae478f5f9646b1354631c6b3ad4d28a9c874d819c69fd3172f42d5a5e813cf7b216798f55dd208f5a0c7d95d82657c1bb63d01df27f16c8ed99d88655b031be0ca7850edc95cfc9498344d22323b7aab18f6deee9f6b84aece315f25b1b1b25d27a1a3ce5f5cc440e7981dc6ec80737551462ae2572622e643335c131e7342d4c1a2dc02f1d5d96d08f591a8a2c2de7867cae39c80143cc39b9961f0c21a7286be469443e66d84e1857a963221be574408e66e4890c730acb1cf950210b0d45be6d2d1ff8dc933a4bfd7c9bc486acec75ad52b7c0bc19e6b1b22ae7b65f33496162d05e2b96127922653393dce409f2fb058378616d61bc0d35406eeb99469ed5342a250d20b7679a636952e88681516e5d6b0a63851c8976c02a75b490cd6c8167865d895cbdce95946ee786c40cf6f7fbdf6294d868c46924994bb390c87b1de9ae6d73b29b1832cd84f964211b2989d34e8f08e44dd401f2400ae4c4841d26250dd00689fce312365edd1e53bacd21f32965e8008c056430c618fd03af1ac57501bd88dc9026b8065f824625f2b966c2e79ff6c75eaeb918732235ad0b4a8ff182f239de0b3b9590135bc7d049c8c328e10364a2cddf4b75234d30a8fc33b83f88c6c8110a5942c8cbec7325a2ce684e493cd28249b5f749196c7dbe6e4a236d504f98cf90a314f93c4db0d192e30c6d20eb66e883a463a23827df76ce475dbb49a73e3f61029d4582679206c8af0d33a04a9c604562dc96ec4b4d4141564c34721f0f91d7dbe730ae25f2b37256743600de8ff3fe377258272e173254b031b4cf91ee4acf0f1c78f9599075a597552ecf0d21b7fbba20c7067adee7b925d8ad54d04b85dcc131f2c21ae489a5017298a25e23615f38e846d8d7eb8202e4d4463e654fef4651284cddb4731bf035f2602ade56c8450c3f422531fc0dcf2b146c63b69c427fb763811ccc5e072277652eadd0dae7c7757867a659252675257272c2ce85ffe165799ae27eed79c78f79672fb2cfbd8d67af549bd319392a493172649653466ed32084ec33c60aef6310f26607f0cf8e5ddee8283541982f2cf5c7e88bd43445ae6fe4baeff2649712b99991fb99c2786a8268a2745c9a20821e32f47a0df6609aa547a1425f07fb5c9d90793cf43acecb3f8e61a72007724c228ee1cbe1bce6610dfb55fa6baeb37536e05503dd9cb89265f068501aa1f442c74be470f775218772eaae24ee054f4207421f614c48d65ea6ca86d01fb47d211b52944277f43c4cdae7dc6f656c6f6fb674ae7245b2661e169d2f479a1564039930468e5ce4b49e5358c24e27136e8449b742922cd0479f63dbe714cf7bba23a6acf6fa7dd81053deea69e4b40d404f6a89dcae2cc01bfbae7c44fe7ee2e06bdddb05261880079077dae72f572779c29282c79c9c2c987381be48d93059461fe34cc453e4bc859e6f65998b910f18e32065b350588f78897d1e79855cbf9423bf3872b32e344553c5b2e636aff7c2843972aa9ed643d87a9f696142271839a61d4fd9a605eed787668967fbdce01c3075feff12340f5b1663871cb0e07b4add4cbdc42572c42e887d8ee74c9499bfc74d8b858d4eedff47d1ea4ff79bdb6f3ef5c3af62d63f7da247ae5f7cf9f2fb7f27727df04f20d7db469e91eb67e4fa19b97e46ae9f91eb67e4fa19b97e46ae9f91eb67e4fa19b97e46ae9f91eb67e4fa19b97e46ae9f91eb67e4fa19b97e46ae9f91eb67e4fa19b97e46ae9f91eb67e4fa19b9febf0a72fdd35dc857fc3af0ac1400837e1c99603b074e57b3c7e775d8728f0d98639f5e070360e9124583b4c3fe180d1c05308156787c82c15eb0ecb0be7593cb800a9d8ad2e34feb466a3a4e4d08fc5419938b050f9bb1666004812f294d6295661a00039b29e2abc43e0a7568b4a29298026054473ac41efea19021035b9af8b6d868ae5260e3c339a73c7dc55e35734d03f21898705878eca96f9f13ca4d0b0a041b0b3c85600ac404d80519f8fd6aa3a8d48a95c74451c07ecf9902be06364352050c44061cae2f39efcaca97dd9eb5d1ed1eb7d181d31e77813d6b5f46c08dbdc561e4ca5e037331666005d323300d73e37110718c3d7f0a63a148e2b7201ba0f4f3d2632c12572aa6f63dc0157a4ca22b0978dc053010a91b2be022523705ee145815063689a7854ea316a3ec6221834760160c70d2346c80019e026ba3c25cf478467f1f9519303e1e87971e4f181313e61d9630aaba792f151de7c64d815f9c98e0384f2cf0a07c9dd89295ad4ce240471ecf20c93ae093af3a3cc55473d3e1fca3eb16b7ff1eafa6a8fffd05181ca13c66d66344a6a6c7bf03f7336c4ae5696dd0e20681adb01258b5ab765cd5dc0487a2c3e34db4f0e78053e9b1285d194bd01c858ad5b0310c7c7b1a2d13572e81eb43a90998dd1c1890164b8832f85a702a723304e604b811b1509ccf12a784a41c7116982be06748799a5502783e93a605f01a8ad800db92b8130e9a8097047e08f834605268d890064e04f31a32fae8fb04fc1b8ec9f719f7470cfc4f877b03ae8334e8d2e336c398458b1d496c05fcce95c76c126bf63c00be00de1631032d8d918d12cc5d870fd12dee0c7824d0650e7ca6c7be25ae2c156d4a607280f94c5c396bb1c4791fd73167e1f1bf5d0c85c47333b495023703965b72ae8115eb71a0881950c43ab1d5547199fdbfec9d4f6fe3ba1547bf92b470f1bce82292c9c472ad987fee75c6bb625c2400b930d001924dbf7b712ee524281ed02e5e81b7d08a1af99f2492971ae8fc4ee09f92046ad22e091c329cd7cf4374de455f0ec6ebf761b9066f2ef757fa00be4ea478720eb4705702433656d872cd4b7fb6fa54a386a2709e437a87d7d90c303af07a2ad1b5f76a5a723ce7a033acda296b39f0f78e821e69f9adff6069a2cfeff0359b4b86b3d1e9092659cbd6a5ce1db46c67ebdf8eeb7a3596af714e024f63b5443bff63ac57ab15da914f783d28f9a37adddb18eec8d0c821940feb17e3ec9cd5be47b85d3821ae69608c77fe913a158cb59a945a9c3bcffa90f87e29fe1cc32daa2835c3dabcb4f1f51654a7c0f814bfb0a475d0f00a43b825b323cb7ccf0b9f68755a8a6da7047bc81a54877b6d3a06adfc4eb0350ac6164e92ef77953a1b60e2c66a2c25fc504e9d5eda79473fd6eb04170f4b9464c3bf4fb90b8785b93296d978f07b56a59049f8d050c4d8e3f470b37c56d4893af524650b6745c6eb103b9fe1e0937cccdfd8ef5d62bed72f767f2c1f9365327a756dbdb2f1ce9ac97b6771fe0926317670bbf5b8acbf792cbf9e99ffb62eea94cea1d5e1503c9cda1eb6f9ce248b239765d7cd4ba9a7f8f0c5a246e15af9f6db751842f76b80d3cbddc6ea64aef5d85a259ff4ac12fd583693f88163e4181cfd990bfdc9f861db9fa3bcd352db6615d8e3ed391997bc85a51ce1e6c6d2dfb9ef39c3dcca76923e1cb2d41fd4d32cf598dee19497355d94acc70c0b9d6433d117f6fec6ed1d937acdfd9e35fd29795b67f6646ac6329f54a8f317953a5dc6a23fc49117e87dae817b8897a5cfe943d8d231f7d7237dd3e603ac27e76dbfff0417f795798005ec61a14f63b16cdc3ec886ba65733115fff7f3eb6d27c58f6448329937279f9985b1f059d63ad6b139d9bc22bb65c73d0d522dcbc2b9eca87fd918f0e9ce933f930fb0da52e6d359b6cc9f515943c82c958dadb11906d98ee9a649f8dedbe9acb3ad156c27b7b1f996ca769f5f6f87a8579ffdf1908a32e7f6b98f3edbb89e9e17465fda6fbe4db17b3dc08687d7db9e7b8ee5dae7b1c03646fa39c587f61a5980dc724a8face94b16e5514aab49117eb7fb75e41e22960fe5fe27968fa165372aebf563e2beaa5cbc32972d33320fd95a6f8cfa582e031903e9cb21fa69caee9d967e6975bd4c47b5357f4a21dc061bc7dcb3140f973c701f9a4b646cc3e952d7e8bbc0bc3c5bce6ba933a5f1abace5b0b10bfb3dc892cfa31686ee6d606ec71ebe33b2c6f9b3cdd9378547b63afb7083e965ceba241f2e93b3f23a32afe1b7f95de6bf541dc33d53d7c74bcb413c1c82cc537c208bc51ab227db35c8c367de6980530d6443dc76260b065319bafa14603e3d637adeab4c63cbc4cdfb6f993dc7be286f53cb867ecb43ca2633bef9de7bf634973a5b2673c992256ace3bff7798fdd92b7d34c26692658b9d271be8a3fcb07b383291d2cd9a34e66fd9273f96ed31e9c57fb2e2e5838cc0d3c214ef02bcf632bf737f9b62f8eb1f6b24fe1fc1cedfa339ffbf1ee2bffc3134e7ea215e3dc4ab8778f510af1ee2d543bc7a88570ff1ea215e3dc4ab8778f510af1ee2d543bc7a88570ff1ea215e3dc4ab8778f510af1ee2d543bc7a88570ff1ea215e3dc4ab87f8cfe421fe3d8433c825259987116d11d858f1138fa6b3aba8a986ec873ca223d61b8aa521cba4194da1619f3d88c88e47e328164d43cce3f462b800df190454a4f894c36d50f7c1eb68cd0655b02f6f8a9fa0a8938e874f75afe128d35d330c9a33dae36a1457b66dfb05ace1fcf5e89d47f08b8297d715acc227b9999a2a144327c7281f285fbd940ff0291464b30a4a4bd37ba227360c008c63c19beef8e051792cddf5a33df66efb0c350371f886e28cb9ff797f549d9244d0a851aa82208ca69d2a13d8e2009e19aa3ea1968da04a4d31cb75de0553eb811778872e15e42f741e7489c7e33bbb1e0d97d881b48111053022d99cf81ca88be9c7caeced7c3a7d56e71534043c104406e4202d2dfdc7e3fda013c7bb4b4e9747f3863ede1fd1d33e733cc96d77b903c304190051000730951838046a5970d15d76db217d610aa768badcedd13084ae9f02af75fd1dfddbe50e4de382ca94a8606f1c73ee368c971dc782f691d7c50fe021bbdc4701493194b4213aa64c0b865288b58212b99f504c83a1ee40c8d0d42ea8f1ee2cedb8cfc594a40e6deb5d230b1a71368dac077374d1fa301ad2ca7b92f8199db1e1851dead6fa9cfb794057859e6bc1325dee66013942ed0aaa6c18b0b557fadc4951d4dc4e4a65ac3af0a2916bd0fdf310fd75101017f14f205451ae17f1e100ee04fa7c0e379f0b7a2f5edb3c3147e3c3cd3725971c4ce3562e865cc536c71fd16e8f8cc35790183bdfc7244adfa04fdd33bfc6729922ca4053aa32462f537620dbb5ed333ce6725443302ec773b83db6f970394901b7a0b531f4987bf4c497b45cdb27ea0718140a53103305e5e934a15e04514b6ecbe79adef4e1c639717d9e16f59a294a0dfd364ce98e805f5314b5ef97a2d430302da59680c81956d4b65fd2fb276ae4c1c468b3aba88a4d699e1c980afa440f8663aa6794c5d9d797dc6d9ded2b0a5e6328a061d086408154bd9d0c135e5ad47b111c0a2d639bc37754aa61ee32fbbd9bc16567d0d4e8ea30969a18d7492f2750e50492fa7e3b80e5a4a202b22768ce3856f4699d4fb9fbed90d0a0756fe0857f8b5ad08cba86b4ff64fb250574a2153493d650e5ecfc08562d0f3734f3ccab63d0eb85eb803632def5a2afb7635490716a853e83bdc5220b46c7f7547060ea10f8a38d4790a5f40efe0802840add703b54e0fbbddb7814c219cdfd3bb8da4f74a52048bcd7c67e16c39ee720fd9cfbb807836beb4a3f489998b7607eccdbb98d817e505307b33ecdcab5cba23f92cca7b1f453e8a79374b3cd0bb0c10c5ac69ce058c510ffd9b0ff3b2a6eefdb3ac3145b440155e1334822dfa3ef37ce99314a9be31712dd70c686367ec71f652c1bdf34eacc89cd14bb37f64db9dc86b16c4ed4d55cd0b6be1f72558b59e4aaf2a5233d1e72578f9fd853d91e519ada7e43fcdd01955eabebdb143bff8c9234bb0e84b36dd79869c11bad2dfe53e9195089b3af82501bb6154c7518c040374f2a95efd8e74e9f5353930eb9fcb660dac3d45492036b6c483ad83e692a5934a50256ced865bff515782e638656b97f00456deb8bb5bedab97daa4917dcf71ecf483a33363f915fe6f1a2263df23aea4269f5e4bed684a5de2e4ad2b6add20734b98691b9ca672fe0dc20e1c2ea0cc25e3c2a5e6968baad2709cc538a9e33ba77c6d3ebad29a6eb7442dbdb5a8e79324dbf94ed1c033874db47ff5a9d7228a597ed02224d34807b2efa6826f67044db6e58b582e31b52bf6840c100b747538e0aebbd6190164991764c39b066710eddaca1b84f15af7486dd81a97fea4623081eb114f9e5898d7cc554181bf382835b5d43cb1fd8c77d08a8bdc556fa9a6dedb5f185661e7cfc030c3c674575fb411dc8b9d8ef3ce7fe3a073b36ab0599fa9ec43e7b527967bfd571e9418f85088bdddba0168ded374242f76fedc65a3b9e7e58fa620e67f4a2aa838049f6117da6a95ad5f925dee2f91eb175bf2ed86b9d057496fd523cc72a0b5e8b72740287b76847fb0d10f5acc2d89e95b905026a7199a6054527da507be747faadb520f611f454d04eb34d84c0ee6deddca2476d1c3a438e254a6f68bca2054575ff7a93e4aa8fb45ee740cb7d4a8de0e602be4c4c21f59538c9c9ea6845fbcfdc8b4b14837b11fb8c29a569597be927db4657eaf97309284495c886a84eb6c6a8c5ba26412d2daf37cd85759478ca05253cd7f84cdf2ab5af5e2d4260d19787db0bf7b2c410b84ec412d0d9e672b57bba6fb1045be7b4f3e0db2f51f88cddd3bf24e7a9c92fc9d500a6cf18f9bcc6dd96ff7b5c824eeebfe19e0d1bfcc7ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce0ca0daedce09f961bfcd7bf010000ffff0300b943c3e0cd4c0300`)))
@@ -23,6 +23,7 @@ let joinCreate = document.getElementById("join-create");
 // Divs
 let gameDiv = document.getElementById("game");
 let clueEntryDiv = document.getElementById("clue-form");
+let lobbyDiv = document.getElementById("lobby");
 let boardDiv = document.getElementById("board");
 let aboutWindow = document.getElementById("about-window");
 let afkWindow = document.getElementById("afk-window");
@@ -37,6 +38,8 @@ let joinBlue = document.getElementById("join-blue");
 let randomizeTeams = document.getElementById("randomize-teams");
 let endTurn = document.getElementById("end-turn");
 let newGame = document.getElementById("new-game");
+let buttonReady = document.getElementById("ready");
+let buttonStartGame = document.getElementById("start-game");
 let clueDeclareButton = document.getElementById("declare-clue");
 let buttonRoleGuesser = document.getElementById("role-guesser");
 let buttonRoleSpymaster = document.getElementById("role-spymaster");
@@ -79,6 +82,7 @@ let playerRole = "guesser";
 let difficulty = "normal";
 let mode = "casual";
 let consensus = "single";
+let ready = false;
 
 // Show the proper toggle options
 buttonModeCasual.disabled = true;
@@ -135,6 +139,14 @@ randomizeTeams.onclick = () => {
 newGame.onclick = () => {
   socket.emit("newGame", {});
 };
+// User is ready to play, the game starts once everyone is
+buttonReady.onclick = () => {
+  socket.emit("ready", { ready: !ready });
+};
+// Host starts the game without waiting for everyone
+buttonStartGame.onclick = () => {
+  socket.emit("startGame");
+};
 clueDeclareButton.onclick = () => {
   if (clueWord.value.length > 0) {
     socket.emit("declareClue", { word: clueWord.value, count: clueCount.value });
@@ -245,7 +257,9 @@ socket.on("serverStats", data => {
   log(data);
   // Client gets server stats
   if (data.sessionId) {
+    // The session id is secret, the room knows this client by its player id
     sessionStorage.setItem("sessionId", data.sessionId);
+    sessionStorage.setItem("playerId", data.playerId);
   }
   if (data.isExistingPlayer) {
     joinDiv.style.display = "none";
@@ -337,6 +351,14 @@ socket.on("reset", () => {
   backToJoin();
 })
 
+socket.on("actionError", data => {
+  // Response to an action the server didn't allow
+  log(data);
+  serverMessage.innerHTML = data.message;
+  serverMessageWindow.style.display = "block";
+  overlay.style.display = "block";
+});
+
 socket.on("gameState", data => {
   // Response to gamestate update
   playerRole = findRole(data.players);
@@ -366,8 +388,8 @@ function wipeBoard() {
   }
 }
 
-function sessionId() {
-  return sessionStorage.getItem("sessionId")
+function playerId() {
+  return sessionStorage.getItem("playerId")
 }
 
 function updateRole(playerRole) {
@@ -390,11 +412,11 @@ function updateRole(playerRole) {
 
 }
 function findTeam(players) {
-  return players[sessionId()].team;
+  return players[playerId()].team;
 }
 
 function findRole(players) {
-  return players[sessionId()].role;
+  return players[playerId()].role;
 }
 
 function updateGameState(data) {
@@ -407,7 +429,9 @@ function updateGameState(data) {
   mode = data.mode; // Update the clients game mode
   consensus = data.consensus; // Update the clients consensus mode
   let team = findTeam(data.players)
+  ready = data.players[playerId()].ready;
   updateInfo(data.game, team); // Update the games turn information
+  updateLobby(data.game, data.host); // Update the ready and start buttons
   updateTimerSlider(data.game, data.mode); // Update the games timer slider
   updatePacks(data.game); // Update the games pack information
   updatePlayerlist(data.players); // Update the player list for the room
@@ -459,6 +483,21 @@ function updateInfo(game, team) {
   }
 }
 
+// Show the ready and start buttons while the game waits for its players
+function updateLobby(game, host) {
+  if (game.phase !== "lobby") {
+    lobbyDiv.style.display = "none";
+    return;
+  }
+  lobbyDiv.style.display = "";
+  buttonReady.innerHTML = ready ? "Not Ready" : "Ready";
+  buttonStartGame.style.display = host === playerId() ? "" : "none";
+  turnMessage.innerHTML = "Waiting for players";
+  turnMessage.className = "";
+  endTurn.disabled = true;
+  clueEntryDiv.style.display = "none";
+}
+
 // Update the clients timer slider
 function updateTimerSlider(game, mode) {
   let minutes = (game.timerAmount - 1) / 60;
@@ -557,6 +596,8 @@ function updatePlayerlist(players) {
       guessProposal.innerText = players[i].guessProposal;
       li.appendChild(guessProposal);
     }
+    // Mark the players who are ready while the game waits in the lobby
+    if (players[i].ready) li.insertBefore(document.createTextNode(" ✓"), li.firstChild.nextSibling);
     // Add the player to their teams ul
     if (players[i].team === "undecided") {
       undefinedList.appendChild(li);
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// maxReplayEvents keeps imported records to a sane size
+const maxReplayEvents = 2000
+
+var (
+	ErrInvalidRecord = ActionError{Code: "invalidRecord", Message: "that's not a game that can be replayed"}
+	ErrReplayRoom    = ActionError{Code: "replayRoom", Message: "the game in a replay room can't be played"}
+	ErrNotReplay     = ActionError{Code: "notReplay", Message: "this room isn't replaying a game"}
+	ErrInvalidStep   = ActionError{Code: "invalidStep", Message: "the game doesn't have that many moves"}
+)
+
+// Replay is an imported game a room steps through, the room's game
+// shows the board after the first Step events of the record.
+type Replay struct {
+	Record *GameRecord `json:"record"`
+	Step   int         `json:"step"`
+}
+
+type replayState struct {
+	Step  int `json:"step"`
+	Steps int `json:"steps"`
+}
+
+// validate checks an imported record before it's replayed, the events
+// are only checked as far as replaying them needs.
+func (rec *GameRecord) validate() error {
+	if rec == nil || rec.Version != gameRecordVersion {
+		return ErrInvalidRecord
+	}
+	if _, ok := GameTypes[rec.GameType]; !ok {
+		return ErrInvalidRecord
+	}
+	if rec.Turn != TeamRed && rec.Turn != TeamBlue {
+		return ErrInvalidRecord
+	}
+	if len(rec.Log) > maxReplayEvents || len(rec.Board) != 5 {
+		return ErrInvalidRecord
+	}
+	for _, row := range rec.Board {
+		if len(row) != 5 {
+			return ErrInvalidRecord
+		}
+	}
+	if rec.GameType == GameTypeDuet {
+		if len(rec.KeyCards[TeamRed]) != boardSize || len(rec.KeyCards[TeamBlue]) != boardSize {
+			return ErrInvalidRecord
+		}
+	}
+	for _, e := range rec.Log {
+		if e.Tile != nil && (e.Tile.I < 0 || e.Tile.I >= 5 || e.Tile.J < 0 || e.Tile.J >= 5) {
+			return ErrInvalidRecord
+		}
+	}
+	return nil
+}
+
+// startReplay turns the room into a read only replay of the record
+func (r *Room) startReplay(rec *GameRecord) {
+	r.Mode = ModeCasual
+	r.GameType = rec.GameType
+	r.Difficulty = rec.Difficulty
+	r.Replay = &Replay{Record: rec}
+	r.replayTo(0)
+}
+
+// ReplayStep shows the game as it was after the given number of events
+func (r *Room) ReplayStep(playerID string, step int) error {
+	if err := r.authorize(playerID, ActionReplay); err != nil {
+		return err
+	}
+	if step < 0 || step > len(r.Replay.Record.Log) {
+		return ErrInvalidStep
+	}
+
+	r.replayTo(step)
+	return nil
+}
+
+// replayTo deals the recorded board again and plays the events up to
+// step on it
+func (r *Room) replayTo(step int) {
+	rec := r.Replay.Record
+	r.Game = &Game{
+		GameType:    rec.GameType,
+		Difficulty:  rec.Difficulty,
+		TimerAmount: rec.TimerAmount,
+		Turn:        rec.Turn,
+		Phase:       PhaseAwaitingClue,
+		Timer:       rec.TimerAmount,
+		Board:       copyBoard(rec.Board),
+		Log:         []GameLog{},
+		keyCards:    rec.KeyCards,
+		record:      rec,
+	}
+	if rec.GameType == GameTypeDuet {
+		r.Game.Red = duetGreensOnSide
+		r.Game.Blue = duetGreensOnSide
+		r.Game.Greens = duetGreens
+		r.Game.TimerTokens = duetTimerTokens
+		for i := range r.Game.Board {
+			for j := range r.Game.Board[i] {
+				r.Game.Board[i][j].Type = ""
+				r.Game.Board[i][j].Bystander = nil
+			}
+		}
+	} else {
+		for _, row := range r.Game.Board {
+			for _, tile := range row {
+				switch tile.Type {
+				case TileTypeRed:
+					r.Game.Red++
+				case TileTypeBlue:
+					r.Game.Blue++
+				}
+			}
+		}
+	}
+
+	for _, e := range rec.Log[:step] {
+		r.replayEvent(e)
+	}
+	if step == len(rec.Log) {
+		r.Game.Over = rec.Over
+		r.Game.Winner = rec.Winner
+		r.Game.Phase = gamePhase(r.Game)
+	}
+	r.Replay.Step = step
+
+	log.WithFields(logrus.Fields{
+		"RoomName": r.Name,
+		"Step":     step,
+		"Steps":    len(rec.Log),
+	}).Info("replaying game")
+}
+
+// replayEvent applies a recorded event to the room's game the way the
+// room did when it was played
+func (r *Room) replayEvent(e GameLog) {
+	g := r.Game
+	g.Log = append(g.Log, e)
+	if e.Undone {
+		return
+	}
+
+	switch e.Event {
+	case "declareClue":
+		g.Clue = e.Clue
+		g.setPhase(PhaseGuessing)
+	case "flipTile":
+		if e.Tile != nil {
+			r.replayFlip(e)
+		}
+		if e.EndedTurn && !g.Over {
+			r.switchTurns()
+		}
+	case "endTurn", "timeout":
+		r.switchTurns()
+	case "outOfTime":
+		var winner *string
+		if g.GameType == GameTypeClassic {
+			ot := otherTeam(e.Team)
+			winner = &ot
+		}
+		g.end(winner)
+	}
+}
+
+func (r *Room) replayFlip(e GameLog) {
+	g := r.Game
+	tile := &g.Board[e.Tile.I][e.Tile.J]
+
+	if g.GameType == GameTypeDuet {
+		switch e.Type {
+		case TileTypeBlack:
+			tile.Flipped = true
+			tile.Type = e.Type
+			g.end(nil)
+		case TileTypeNeutral:
+			tile.Bystander = append(tile.Bystander, g.Turn)
+		case TileTypeGreen:
+			tile.Flipped = true
+			tile.Type = e.Type
+			g.Greens--
+			g.Red = g.duetGreensLeft(TeamRed)
+			g.Blue = g.duetGreensLeft(TeamBlue)
+		}
+		return
+	}
+
+	tile.Flipped = true
+	switch tile.Type {
+	case TileTypeBlack:
+		g.end(nil)
+	case TileTypeBlue:
+		g.Blue--
+	case TileTypeRed:
+		g.Red--
+	}
+	if (g.Red == 0 || g.Blue == 0) && !g.Over {
+		g.end(nil)
+	}
+}
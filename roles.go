@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// RolePolicyManual leaves the roles to the players
+	RolePolicyManual     = "manual"
+	RolePolicyRandom     = "random"
+	RolePolicyRoundRobin = "roundRobin"
+	RolePolicies         = buildSet(RolePolicyManual, RolePolicyRandom, RolePolicyRoundRobin)
+)
+
+var ErrNoEligiblePlayer = ActionError{Code: "noEligiblePlayer", Message: "both teams need a player who can be spymaster"}
+
+// ChangeRolePolicy sets how spymasters are picked when a game starts
+func (r *Room) ChangeRolePolicy(playerID, policy string) error {
+	if _, ok := RolePolicies[policy]; !ok {
+		return ErrInvalidSetting
+	}
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+
+	r.RolePolicy = policy
+	return nil
+}
+
+// startGameWithRoles starts a new game and picks its spymasters, the
+// game isn't started when a team has nobody to pick.
+func (r *Room) startGameWithRoles(seed int64) error {
+	picking := r.picksSpymasters()
+	if picking {
+		for _, team := range []string{TeamRed, TeamBlue} {
+			if len(r.eligibleSpymasters(team)) == 0 {
+				return ErrNoEligiblePlayer
+			}
+		}
+	}
+
+	if err := r.startGame(seed); err != nil {
+		return err
+	}
+	if picking {
+		r.assignSpymasters()
+	}
+	return nil
+}
+
+// picksSpymasters tells if the room's policy picks the spymasters of
+// the next game, duet games don't have any.
+func (r *Room) picksSpymasters() bool {
+	return r.RolePolicy != RolePolicyManual && r.GameType == GameTypeClassic
+}
+
+// eligibleSpymasters are the players of the team who are connected,
+// in the order they joined the room
+func (r *Room) eligibleSpymasters(team string) []*Player {
+	players := []*Player{}
+	for _, p := range r.teamPlayers(team) {
+		if p.Role != PlayerRoleSpectator && !p.Away {
+			players = append(players, p)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool {
+		if !players[i].JoinedAt.Equal(players[j].JoinedAt) {
+			return players[i].JoinedAt.Before(players[j].JoinedAt)
+		}
+		return players[i].ID < players[j].ID
+	})
+	return players
+}
+
+// assignSpymasters makes exactly one player of each team its spymaster
+// and announces who it is, teams without an eligible player are skipped.
+func (r *Room) assignSpymasters() {
+	if r.Spymasters == nil {
+		r.Spymasters = map[string]string{}
+	}
+
+	for _, team := range []string{TeamRed, TeamBlue} {
+		players := r.eligibleSpymasters(team)
+		if len(players) == 0 {
+			continue
+		}
+
+		var next *Player
+		switch r.RolePolicy {
+		case RolePolicyRandom:
+			next = players[rand.Intn(len(players))]
+		default:
+			// the player after the last spymaster, the first one when
+			// the last spymaster left
+			next = players[0]
+			for i, p := range players {
+				if p.ID == r.Spymasters[team] {
+					next = players[(i+1)%len(players)]
+				}
+			}
+		}
+
+		for _, p := range r.teamPlayers(team) {
+			if p.Role == PlayerRoleSpyMaster {
+				p.Role = PlayerRoleGuesser
+			}
+		}
+		next.Role = PlayerRoleSpyMaster
+		r.Spymasters[team] = next.ID
+
+		r.announce(fmt.Sprintf("%s is the %s spymaster", next.NickName, team))
+		log.WithFields(logrus.Fields{
+			"RoomName": r.Name,
+			"Team":     team,
+			"PlayerID": next.ID,
+			"Policy":   r.RolePolicy,
+		}).Info("assigned spymaster")
+	}
+}
@@ -2,14 +2,19 @@ package main
 
 import (
 	"math/rand"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	ConsensusSingle = "single"
-	ConsensusAll    = "consensus"
-	ConsensusTypes  = buildSet(ConsensusAll, ConsensusSingle)
+	ConsensusSingle     = "single"
+	ConsensusAll        = "consensus"
+	ConsensusMajority   = "majority"
+	ConsensusQuorum     = "quorum"
+	ConsensusAutoCommit = "autoCommit"
+	ConsensusTypes      = buildSet(ConsensusAll, ConsensusSingle, ConsensusMajority, ConsensusQuorum, ConsensusAutoCommit)
 )
 
 var (
@@ -24,47 +29,112 @@ var (
 	DifficultyTypes  = buildSet(DifficultyNormal, DifficultyHard)
 )
 
-type BoardType int
-
-const (
-	BoardTypeDefault BoardType = 1 << iota
-	BoardTypeDuet
-	BoardTypeUndercover
-	BoardTypeCustom
-	BoardTypeNsfw
+var (
+	GameTypeClassic = "classic"
+	GameTypeDuet    = "duet"
+	GameTypes       = buildSet(GameTypeClassic, GameTypeDuet)
 )
 
 var (
 	PlayerRoleGuesser   = "guesser"
 	PlayerRoleSpyMaster = "spymaster"
 	PlayerRoleSpectator = "spectator"
+	PlayerRoles         = buildSet(PlayerRoleGuesser, PlayerRoleSpyMaster, PlayerRoleSpectator)
 )
 
+// Room is a game and its players, the password is only kept hashed
 type Room struct {
 	Name       string             `json:"room"`
-	Password   string             `json:"password"`
+	Password   string             `json:"passwordHash"`
 	Players    map[string]*Player `json:"players"`
 	Difficulty string             `json:"difficulty"`
 	Mode       string             `json:"mode"`
+	Timers     TimerSettings      `json:"timers"`
 	Consesus   string             `json:"consensus"`
+	GameType   string             `json:"gameType"`
 	Game       *Game              `json:"game"`
 
-	boardType   BoardType
+	// moderation, the host is the player id of the room owner,
+	// banned players are kept out by their id and their address
+	Host           string              `json:"host"`
+	SettingsLocked bool                `json:"settingsLocked"`
+	Banned         map[string]struct{} `json:"banned"`
+	BannedAddrs    map[string]struct{} `json:"bannedAddrs"`
+
+	Chat []ChatMessage `json:"chat"`
+
+	ClueRules map[string]bool `json:"clueRules"`
+
+	ConsensusSettings ConsensusSettings `json:"consensusSettings"`
+
+	// ids of the packs boards are dealt from and the packs
+	// uploaded by the players of this room
+	WordPacks   []string             `json:"wordPacks"`
+	RoomPacks   map[string]*WordPack `json:"roomPacks"`
+	PackWeights map[string]int       `json:"packWeights"`
+
+	// how spymasters are picked when a game starts, and the last
+	// spymaster of each team for round-robin
+	RolePolicy string            `json:"rolePolicy"`
+	Spymasters map[string]string `json:"spymasters,omitempty"`
+
+	Series Series `json:"series"`
+
+	// games played in this room, and the game being replayed in
+	// a replay room
+	History []*GameRecord `json:"history"`
+	Replay  *Replay       `json:"replay,omitempty"`
+
+	Paused *Pause `json:"paused,omitempty"`
+
 	timerAmount float64
+
+	// the game before each of the last moves, and the players who
+	// asked to take back the last one
+	undo      []*Game
+	undoVotes map[string]struct{}
+
+	// players who asked to pause or resume the game
+	pauseVotes map[string]struct{}
+
+	// results of games that just ended, and ids of games whose end
+	// was taken back, waiting for the stats
+	results []GameResult
+	undone  []string
+
+	// server messages waiting to be sent to the players
+	announcements []ChatMessage
 }
 
 func NewRoom(name, password string) *Room {
-	return &Room{
-		Name:        name,
-		Password:    password,
-		Players:     map[string]*Player{},
-		Difficulty:  DifficultyNormal,
-		Mode:        ModeCasual,
-		Consesus:    ConsensusSingle,
-		Game:        NewGame(BoardTypeDefault, 5*60),
-		boardType:   BoardTypeDefault,
-		timerAmount: 5 * 60,
-	}
+	r := &Room{
+		Name:              name,
+		Password:          hashPassword(password),
+		Players:           map[string]*Player{},
+		Difficulty:        DifficultyNormal,
+		Mode:              ModeCasual,
+		Consesus:          ConsensusSingle,
+		ConsensusSettings: defaultConsensusSettings(),
+		GameType:          GameTypeClassic,
+		Banned:            map[string]struct{}{},
+		BannedAddrs:       map[string]struct{}{},
+		Chat:              []ChatMessage{},
+		ClueRules:         defaultClueRules(),
+		WordPacks:         []string{defaultPackID},
+		RoomPacks:         map[string]*WordPack{},
+		PackWeights:       map[string]int{},
+		RolePolicy:        RolePolicyManual,
+		timerAmount:       5 * 60,
+	}
+
+	game, err := NewGame(r.selectedPacks(), r.PackWeights, r.Difficulty, r.timerAmount, newSeed())
+	if err != nil {
+		// the built in base pack is checked to fill a board when the server starts
+		panic(err)
+	}
+	game.Phase = PhaseLobby
+	r.Game = game
+	return r
 }
 
 func (r *Room) Join(playerID, name string) bool {
@@ -86,6 +156,10 @@ func (r *Room) Join(playerID, name string) bool {
 		Team:          randTeam,
 		GuessProposal: nil,
 		Role:          PlayerRoleGuesser,
+		JoinedAt:      time.Now(),
+	}
+	if r.Host == "" {
+		r.Host = playerID
 	}
 	return true
 }
@@ -101,24 +175,51 @@ func (r *Room) hasPlayer(name string) bool {
 
 func (r *Room) Leave(playerID string) bool {
 	delete(r.Players, playerID)
+	if r.IsHost(playerID) {
+		r.pickNewHost()
+	}
+	// the players left may be all ready now
+	r.startIfReady()
 	return true
 }
 
-func (r *Room) ChangeTeam(playerID, team string) {
-	player, ok := r.Player(playerID)
-	if !ok {
-		log.WithFields(logrus.Fields{
-			"PlayerID": playerID,
-			"RoomName": r.Name,
-			"Team":     team,
-		}).Warn("player tried to change teams but they are not in the room")
+func (r *Room) SetAway(playerID string, away bool) {
+	if p, ok := r.Player(playerID); ok {
+		p.Away = away
+	}
+}
+
+func (r *Room) SetAfkTimer(playerID string, timeout, left int) {
+	if p, ok := r.Player(playerID); ok {
+		p.Timeout = timeout
+		p.AfkTimer = left
+	}
+}
 
-		return
+// ChangeTeam moves the player to the team, teams are fixed while a
+// game is being played.
+func (r *Room) ChangeTeam(playerID, team string) error {
+	if _, ok := Teams[team]; !ok {
+		return ErrInvalidSetting
 	}
-	player.Team = team
+	if err := r.authorize(playerID, ActionChangeTeam); err != nil {
+		return err
+	}
+
+	r.setTeam(r.Players[playerID], team)
+	return nil
 }
 
-func (r *Room) RandomizeTeams(playerID string) {
+func (r *Room) setTeam(p *Player, team string) {
+	p.Team = team
+	r.unready(p)
+}
+
+func (r *Room) RandomizeTeams(playerID string) error {
+	if err := r.authorize(playerID, ActionRandomizeTeams); err != nil {
+		return err
+	}
+
 	players := []*Player{}
 	for _, p := range r.Players {
 		if p.Role != PlayerRoleSpectator {
@@ -126,6 +227,7 @@ func (r *Room) RandomizeTeams(playerID string) {
 			p.GuessProposal = nil
 		}
 	}
+	rand.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
 
 	for i := 0; i < len(players)/2; i++ {
 		players[i].Team = TeamBlue
@@ -135,144 +237,209 @@ func (r *Room) RandomizeTeams(playerID string) {
 		players[i].Team = TeamRed
 	}
 
-	rand.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+	// the old spymasters may have ended up on the same team
+	if r.picksSpymasters() {
+		r.assignSpymasters()
+	}
+	return nil
 }
 
-func (r *Room) NewGame() {
-	r.Game = NewGame(r.boardType, r.timerAmount)
+func (r *Room) NewGame(playerID string) error {
+	if err := r.authorize(playerID, ActionNewGame); err != nil {
+		return err
+	}
+	return r.startGameWithRoles(r.gameSeed())
+}
+
+// startGame deals the board of the seed, the current game is kept
+// when the selected packs can't fill one. Players keep their roles.
+func (r *Room) startGame(seed int64) error {
+	var game *Game
+	var err error
+	if r.GameType == GameTypeDuet {
+		game, err = NewDuetGame(r.selectedPacks(), r.PackWeights, r.Difficulty, r.timerAmount, seed)
+	} else {
+		game, err = NewGame(r.selectedPacks(), r.PackWeights, r.Difficulty, r.timerAmount, seed)
+	}
+	if err != nil {
+		return err
+	}
+	r.archiveGame()
+	r.clearUndo()
+	r.clearPause()
+	game.Phase = PhaseLobby
+	r.Game = game
+	r.applyTimers(game)
 
 	r.clearGuessProposals()
+	r.startSeriesGame()
+	return nil
+}
 
-	for _, p := range r.Players {
-		if p.Role == PlayerRoleSpyMaster {
-			p.Role = PlayerRoleGuesser
-		}
+// SwitchRole changes the player's role, roles are fixed while a game
+// is being played so nobody looks at the key and goes back to guessing.
+func (r *Room) SwitchRole(playerID, role string) error {
+	if _, ok := PlayerRoles[role]; !ok {
+		return ErrInvalidSetting
+	}
+	if err := r.authorize(playerID, roleAction(role)); err != nil {
+		return err
 	}
+
+	r.setRole(r.Players[playerID], role)
+	return nil
 }
 
-func (r *Room) SwitchRole(playerID, role string) (string, bool) {
-	p, ok := r.Player(playerID)
-	if !ok {
-		log.WithFields(logrus.Fields{
-			"PlayerID": playerID,
-			"RoomName": r.Name,
-			"Role":     role,
-		}).Warn("player tried to switch roles but they are not in the room")
-		return "player not a member of the room", false
+// roleAction is the permission needed to take the role, during a game
+// anyone may step aside and a team without a spymaster may pick one
+func roleAction(role string) Action {
+	switch role {
+	case PlayerRoleSpectator:
+		return ActionSpectate
+	case PlayerRoleSpyMaster:
+		return ActionTakeSpymaster
 	}
+	return ActionSwitchRole
+}
+
+func (r *Room) setRole(p *Player, role string) {
 	p.Role = role
 	if role == PlayerRoleSpectator {
 		p.Team = "undecided"
 	}
-	return role, true
+	r.unready(p)
 }
 
-func (r *Room) ChangeDifficulty(playerID, difficulty string) {
-	player := r.PlayerLogged(playerID, "player tried to change difficulty but failed successfully")
-	if player.Role == PlayerRoleSpectator {
-		return
+// ChangeDifficulty applies to the next game, a board nobody played
+// on yet is dealt again right away.
+func (r *Room) ChangeDifficulty(playerID, difficulty string) error {
+	if _, ok := DifficultyTypes[difficulty]; !ok {
+		return ErrInvalidSetting
 	}
 
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+
+	if r.Difficulty == difficulty {
+		return nil
+	}
+	if !r.Game.Over && len(r.Game.Log) == 0 {
+		previous := r.Difficulty
+		r.Difficulty = difficulty
+		if err := r.startGame(r.gameSeed()); err != nil {
+			r.Difficulty = previous
+			return err
+		}
+	}
 	r.Difficulty = difficulty
+	return nil
 }
 
-func (r *Room) SwitchMode(playerID, mode string) {
+func (r *Room) SwitchMode(playerID, mode string) error {
 	if _, ok := ModeTypes[mode]; !ok {
-		return
+		return ErrInvalidSetting
 	}
 
-	player := r.PlayerLogged(playerID, "player tried to change modes but failed successfully")
-	if player.Role == PlayerRoleSpectator {
-		return
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
 	}
 
 	r.Mode = mode
+	return nil
+}
+
+func (r *Room) SwitchGameType(playerID, gameType string) error {
+	if _, ok := GameTypes[gameType]; !ok {
+		return ErrInvalidSetting
+	}
+
+	if err := r.authorize(playerID, ActionSwitchGameType); err != nil {
+		return err
+	}
+
+	if r.GameType == gameType {
+		return nil
+	}
+	previous := r.GameType
+	r.GameType = gameType
+	if err := r.startGame(r.gameSeed()); err != nil {
+		r.GameType = previous
+		return err
+	}
+	return nil
 }
 
-func (r *Room) SwitchConsensus(playerID, consensus string) {
-	player := r.PlayerLogged(playerID, "player tried to ask for consent but failed successfully")
-	if player.Role == PlayerRoleSpectator {
-		return
+func (r *Room) SwitchConsensus(playerID, consensus string) error {
+	if _, ok := ConsensusTypes[consensus]; !ok {
+		return ErrInvalidSetting
+	}
+
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
 	}
 
 	r.Consesus = consensus
+	return nil
 }
 
-func (r *Room) EndTurn(playerID string) {
-	logEntry := GameLog{
+func (r *Room) EndTurn(playerID string) error {
+	if err := r.authorize(playerID, ActionEndTurn); err != nil {
+		return err
+	}
+	if err := r.Game.checkPhase(PhaseAwaitingClue); err != nil {
+		return err
+	}
+	// duet games end when the turns run out
+	defer r.checkGameOver(r.Game.Over)
+
+	r.saveUndo()
+	r.clearGuessProposals()
+	r.logEvent(r.Players[playerID], GameLog{
 		Event:     "endTurn",
 		Team:      r.Game.Turn,
 		EndedTurn: true,
-	}
-	r.clearGuessProposals()
-	r.Game.Log = append(r.Game.Log, logEntry)
+	})
 	r.switchTurns()
+	return nil
 }
 
-func (r *Room) SelectTile(playerID string, i, j int) {
-	p, ok := r.Player(playerID)
-	if !ok {
-		log.WithFields(logrus.Fields{
-			"PlayerID": playerID,
-			"RoomName": r.Name,
-		}).Warn("player tried to click tile but they are not in the room")
-		return
+func (r *Room) SelectTile(playerID string, i, j int) error {
+	if err := r.authorize(playerID, ActionSelectTile); err != nil {
+		return err
 	}
-	if p.Team != r.Game.Turn {
-		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
-			"PlayerName": p.NickName,
-			"RoomName":   r.Name,
-			"PlayerTeam": p.Team,
-			"TurnTeam":   r.Game.Turn,
-		}).Info("player tried to click tile but it's not their turn")
-		return
+	defer r.checkGameOver(r.Game.Over)
+	if i < 0 || i >= len(r.Game.Board) || j < 0 || j >= len(r.Game.Board[i]) {
+		return ErrInvalidTile
 	}
 
-	if p.Role == PlayerRoleSpectator {
-		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
-			"PlayerName": p.NickName,
-			"RoomName":   r.Name,
-			"Role":       p.Role,
-		}).Info("specator being naughty and clicking words.")
-		return
-	}
+	return r.selectTile(r.Players[playerID], i, j, false)
+}
 
-	if p.Role == PlayerRoleSpyMaster {
-		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
-			"PlayerName": p.NickName,
-			"RoomName":   r.Name,
-			"Role":       p.Role,
-		}).Info("player tried to click tile but they are the spymaster")
-		return
+// selectTile flips a tile for a player allowed to guess, a committed
+// proposal already has the team's agreement.
+func (r *Room) selectTile(p *Player, i, j int, committed bool) error {
+	if r.Game.GameType == GameTypeDuet {
+		r.selectDuetTile(p, i, j, committed)
+		return nil
 	}
 
-	if r.Game.Clue == nil {
-		// no clue, can't play
-		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
-			"PlayerName": p.NickName,
-			"RoomName":   r.Name,
-		}).Info("player tried to click tile but they are is no clue")
-		return
-	} else if r.Game.turnsTaken >= r.Game.Clue.Count+1 {
-		// can only make clue+1 turns max
+	if r.Game.guessesExhausted() {
+		// can only make clue+1 turns max, clue turns on hard
 		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
+			"PlayerID":   p.ID,
 			"PlayerName": p.NickName,
 			"RoomName":   r.Name,
 			"TurnsTake":  r.Game.turnsTaken,
 			"ClueCount":  r.Game.Clue.Count,
 		}).Info("player tried to click time but they don't have clues left")
-		return
+		return ErrNoGuessesLeft
 	}
 
 	tile := &r.Game.Board[i][j]
 
 	log.WithFields(logrus.Fields{
-		"PlayerID":   playerID,
+		"PlayerID":   p.ID,
 		"PlayerName": p.NickName,
 		"RoomName":   r.Name,
 		"Tile":       tile.Word,
@@ -281,37 +448,38 @@ func (r *Room) SelectTile(playerID string, i, j int) {
 	}).Info("player flipping tile")
 
 	if tile.Flipped {
-		return
+		return nil
 	}
 
-	if r.Consesus == ConsensusAll && !r.playerHasConsensus(p, i, j) {
+	if !committed && !r.hasConsensus(p, i, j) {
 		log.WithFields(logrus.Fields{
-			"PlayerID":   playerID,
+			"PlayerID":   p.ID,
 			"PlayerName": p.NickName,
 			"RoomName":   r.Name,
 			"Tile":       tile.Word,
 		}).Info("player tried to flip tile but they don't have consensus")
-		return
+		return nil
 	}
 
+	r.saveUndo()
 	tile.Flipped = true
 
 	logEntry := GameLog{
 		Event: "flipTile",
+		Tile:  &Position{I: i, J: j},
 		Word:  tile.Word,
 		Type:  tile.Type,
 		Team:  p.Team,
 	}
 
+	var winner *string
 	switch tile.Type {
 	case TileTypeBlack:
-		r.Game.Over = true
 		ot := otherTeam(p.Team)
-		r.Game.Winner = &ot
+		winner = &ot
 		logEntry.EndedTurn = true
 
 	case TileTypeNeutral:
-		r.switchTurns()
 		logEntry.EndedTurn = true
 
 	case TileTypeBlue:
@@ -319,7 +487,6 @@ func (r *Room) SelectTile(playerID string, i, j int) {
 		if p.Team == TeamBlue {
 			r.Game.turnsTaken++
 		} else {
-			r.switchTurns()
 			logEntry.EndedTurn = true
 		}
 
@@ -328,47 +495,28 @@ func (r *Room) SelectTile(playerID string, i, j int) {
 		if p.Team == TeamRed {
 			r.Game.turnsTaken++
 		} else {
-			r.switchTurns()
 			logEntry.EndedTurn = true
 		}
 	}
 
 	r.clearGuessProposals()
 
-	if r.Game.Clue != nil && r.Game.turnsTaken >= r.Game.Clue.Count+1 {
-		r.switchTurns()
-		logEntry.EndedTurn = true
-	}
-
 	if r.Game.Blue == 0 {
-		r.Game.Winner = &TeamBlue
-		r.Game.Over = true
+		winner = &TeamBlue
 	} else if r.Game.Red == 0 {
-		r.Game.Winner = &TeamRed
-		r.Game.Over = true
+		winner = &TeamRed
 	}
 
-	r.Game.Log = append(r.Game.Log, logEntry)
-}
-
-func (r *Room) playerHasConsensus(p *Player, i, j int) bool {
-	word := r.Game.Board[i][j].Word
-
-	if p.GuessProposal != nil && *p.GuessProposal == word {
-		p.GuessProposal = nil
-		return false
+	switch {
+	case winner != nil:
+		r.Game.end(winner)
+	case logEntry.EndedTurn || r.Game.guessesExhausted():
+		r.switchTurns()
+		logEntry.EndedTurn = true
 	}
 
-	p.GuessProposal = &word
-	for _, tp := range r.teamPlayers(p.Team) {
-		if tp.Role == PlayerRoleSpyMaster || tp.Role == PlayerRoleSpectator {
-			continue
-		}
-		if tp.GuessProposal == nil || *tp.GuessProposal != word {
-			return false
-		}
-	}
-	return true
+	r.logEvent(p, logEntry)
+	return nil
 }
 
 func otherTeam(team string) string {
@@ -379,19 +527,39 @@ func otherTeam(team string) string {
 }
 
 func (r *Room) switchTurns() {
+	next := otherTeam(r.Game.Turn)
+	if r.Game.GameType == GameTypeDuet {
+		r.spendTimerToken()
+		if r.Game.Over {
+			return
+		}
+		next = r.nextDuetTurn()
+	}
+
 	log.WithFields(logrus.Fields{
 		"FromTeam": r.Game.Turn,
-		"ToTeam":   otherTeam(r.Game.Turn),
+		"ToTeam":   next,
 	}).Info("Switching teams")
 
 	r.clearGuessProposals()
-	r.Game.Timer = r.Game.TimerAmount
-	r.Game.Turn = otherTeam(r.Game.Turn)
+	r.Game.Turn = next
 	r.Game.turnsTaken = 0
 	r.Game.Clue = nil
+	r.Game.setPhase(PhaseAwaitingClue)
+	r.Game.Timer = r.Game.phaseTime()
 }
+
+// guessingTeam is the team flipping tiles this turn, in duet the clue
+// comes from the team holding the turn and the other side guesses.
+func (r *Room) guessingTeam() string {
+	if r.Game.GameType == GameTypeDuet {
+		return otherTeam(r.Game.Turn)
+	}
+	return r.Game.Turn
+}
+
 func (r *Room) clearGuessProposals() {
-	for _, tp := range r.teamPlayers(r.Game.Turn) {
+	for _, tp := range r.teamPlayers(r.guessingTeam()) {
 		tp.GuessProposal = nil
 	}
 }
@@ -411,45 +579,74 @@ func (r *Room) teamPlayers(team string) []*Player {
 	return players
 }
 
-func (r *Room) DeclareClue(playerID, clue string, count int) {
-	if len(clue) == 0 {
-		r.PlayerLogged(playerID, "empty clue provided")
+func (r *Room) DeclareClue(playerID, word string, count int) error {
+	if err := r.authorize(playerID, ActionDeclareClue); err != nil {
+		return err
 	}
 
-	r.Game.Clue = &Clue{
-		Word:  clue,
+	clue := Clue{
+		Word:  strings.TrimSpace(word),
 		Count: count,
 	}
-	r.Game.Log = append(r.Game.Log, GameLog{
+	if err := r.Game.checkPhase(PhaseGuessing); err != nil {
+		return err
+	}
+	if len(clue.Word) == 0 {
+		return ErrEmptyClue
+	}
+	if err := r.validateClue(r.Game.Turn, clue); err != nil {
+		log.WithFields(logrus.Fields{
+			"PlayerID": playerID,
+			"RoomName": r.Name,
+			"Clue":     clue.Word,
+			"Count":    clue.Count,
+			"Error":    err,
+		}).Info("rejected clue")
+		return err
+	}
+
+	r.saveUndo()
+	r.Game.Clue = &clue
+	r.Game.setPhase(PhaseGuessing)
+	if r.Game.GuessTime > 0 {
+		r.Game.Timer = r.Game.GuessTime
+	}
+	r.logEvent(r.Players[playerID], GameLog{
 		Event: "declareClue",
 		Clue:  r.Game.Clue,
 		Team:  r.Game.Turn,
 	})
-
+	return nil
 }
 
-func (r *Room) ChangeCards(playerID, pack string) {
-	if pack == "base" {
-		r.Game.Base = !r.Game.Base
-		r.boardType = r.boardType ^ BoardTypeDefault
+func (r *Room) ChangeCards(playerID, pack string) error {
+	if err := r.authorize(playerID, ActionChangeCards); err != nil {
+		return err
 	}
-	if pack == "duet" {
-		r.Game.Duet = !r.Game.Duet
-		r.boardType = r.boardType ^ BoardTypeDuet
+
+	if _, ok := r.wordPack(pack); !ok {
+		return ErrUnknownPack
 	}
-	if pack == "undercover" {
-		r.Game.Undercover = !r.Game.Undercover
-		r.boardType = r.boardType ^ BoardTypeUndercover
+
+	selected := []string{}
+	for _, id := range r.WordPacks {
+		if id != pack {
+			selected = append(selected, id)
+		}
 	}
-	if pack == "custom" {
-		r.Game.Custom = !r.Game.Custom
-		r.boardType = r.boardType ^ BoardTypeCustom
+	if len(selected) == len(r.WordPacks) {
+		selected = append(selected, pack)
 	}
-	if pack == "nsfw" {
-		r.Game.Nsfw = !r.Game.Nsfw
-		r.boardType = r.boardType ^ BoardTypeNsfw
+	previous := r.WordPacks
+	r.WordPacks = selected
+	if _, err := packShares(r.selectedPacks(), r.PackWeights, boardSize); err != nil {
+		r.WordPacks = previous
+		return err
 	}
-	r.Game.WordPool = wordpoolSize(r.boardType)
+
+	// the board stays until the next game, only the selection shown changes
+	r.Game.setWordPacks(r.selectedPacks())
+	return nil
 }
 
 func (r *Room) GameState() gameState {
@@ -462,55 +659,101 @@ func (r *Room) GameState() gameState {
 		players[p] = *r.Players[p]
 	}
 
+	game.Log = playLog(game.Log)
+
+	var replay *replayState
+	if r.Replay != nil {
+		replay = &replayState{
+			Step:  r.Replay.Step,
+			Steps: len(r.Replay.Record.Log),
+		}
+	}
+
 	return gameState{
-		Room:       r.Name,
-		Game:       &game,
-		Difficulty: r.Difficulty,
-		Consensus:  r.Consesus,
-		Mode:       r.Mode,
-		Players:    players,
+		Room:              r.Name,
+		Game:              &game,
+		Difficulty:        r.Difficulty,
+		Consensus:         r.Consesus,
+		ConsensusSettings: r.ConsensusSettings,
+		Mode:              r.Mode,
+		Timers:            r.Timers,
+		GameType:          r.GameType,
+		Players:           players,
+		Host:              r.Host,
+		Locked:            r.SettingsLocked,
+		ClueRules:         r.ClueRules,
+		WordPacks:         r.availablePacks(),
+		PackWeights:       r.PackWeights,
+		RolePolicy:        r.RolePolicy,
+		Paused:            r.Paused,
+		PauseVotes:        r.pauseVoters(),
+		Replay:            replay,
+		Undo:              r.undoProgress(),
+		Series:            r.Series,
 	}
 }
 
-func (r *Room) ChangeTimer(playerID string, value float64) {
-	player := r.PlayerLogged(playerID, "player tried to change time zones but failed successfully")
-	if player.Role == PlayerRoleSpectator {
-		return
+// GameStateFor returns the game state as seen by the given player.
+// Once the game left the lobby spymasters see the key, duet players
+// see their half of it and everyone sees it when the game is over.
+func (r *Room) GameStateFor(playerID string) gameState {
+	gs := r.GameState()
+	if r.Game == nil {
+		return gs
 	}
 
-	r.timerAmount = value * 60
-	r.Game.TimerAmount = r.timerAmount
-	r.Game.Timer = r.timerAmount
-}
+	role := PlayerRoleSpectator
+	team := ""
+	if p, ok := r.Player(playerID); ok {
+		role = p.Role
+		team = p.Team
+	}
 
-type TickerState int
+	switch {
+	case r.Game.Phase == PhaseLobby && r.Replay == nil:
+		// roles can still change in the lobby, so nobody sees the key
+		// until the game starts
+		gs.Game.Board = hiddenBoardView(r.Game.Board)
 
-const (
-	TickerStateContinue TickerState = iota
-	TickerStateStop
-)
+	case r.Game.GameType == GameTypeDuet:
+		var key []string
+		if role != PlayerRoleSpectator {
+			key = r.Game.keyCards[team]
+		}
+		gs.Game.Board = duetBoardView(r.Game.Board, key)
+		if r.Game.Over {
+			gs.BoardCode = boardCode(r.Game.seed)
+		}
 
-func (r *Room) TimerTick() (TickerState, bool) {
-	if r.Mode != "timed" {
-		return TickerStateStop, false
-	}
-	if r.Game.Over {
-		return TickerStateContinue, true
+	case r.Game.Over || role == PlayerRoleSpyMaster || r.Replay != nil:
+		gs.Game.Board = copyBoard(r.Game.Board)
+		gs.BoardCode = boardCode(r.Game.seed)
+		if !r.Game.Over && role == PlayerRoleSpyMaster && r.Game.Difficulty == DifficultyHard {
+			gs.Game.Log = clueLog(r.Game.Log)
+		}
+
+	default:
+		gs.Game.Board = hiddenBoardView(r.Game.Board)
 	}
+	return gs
+}
 
-	if r.Game.Timer > 0 {
-		r.Game.Timer--
+// ChangeTimer sets the minutes of a turn, it applies to the next game
+// and right away to a board nobody played on yet.
+func (r *Room) ChangeTimer(playerID string, value float64) error {
+	if value <= 0 || value*60 > maxTimerSeconds {
+		return ErrInvalidSetting
 	}
-	if r.Game.Timer == 0 {
-		r.Game.Log = append(r.Game.Log, GameLog{
-			Event:     "timeout",
-			Team:      r.Game.Turn,
-			EndedTurn: true,
-		})
-		r.switchTurns()
-		return TickerStateContinue, true
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+
+	r.timerAmount = value * 60
+	if !r.Game.Over && len(r.Game.Log) == 0 {
+		r.Game.TimerAmount = r.timerAmount
+		r.Game.Timer = r.Game.phaseTime()
 	}
-	return TickerStateContinue, false
+	return nil
 }
 
 // PlayerLogged ... Get a player and automatically log if not ok
@@ -541,10 +784,29 @@ func buildSet(elem ...string) map[string]struct{} {
 }
 
 type gameState struct {
-	Room       string            `json:"room"`
-	Players    map[string]Player `json:"players"`
-	Game       *Game             `json:"game,omitempty"`
-	Difficulty string            `json:"difficulty"`
-	Mode       string            `json:"mode"`
-	Consensus  string            `json:"consensus"`
+	Room              string            `json:"room"`
+	Players           map[string]Player `json:"players"`
+	Game              *Game             `json:"game,omitempty"`
+	Difficulty        string            `json:"difficulty"`
+	Mode              string            `json:"mode"`
+	Timers            TimerSettings     `json:"timers"`
+	Consensus         string            `json:"consensus"`
+	ConsensusSettings ConsensusSettings `json:"consensusSettings"`
+	GameType          string            `json:"gameType"`
+	Host              string            `json:"host"`
+	Locked            bool              `json:"settingsLocked"`
+	ClueRules         map[string]bool   `json:"clueRules"`
+	WordPacks         []WordPackInfo    `json:"availablePacks"`
+	PackWeights       map[string]int    `json:"packWeights"`
+	RolePolicy        string            `json:"rolePolicy"`
+	Paused            *Pause            `json:"paused"`
+	PauseVotes        []string          `json:"pauseVotes"`
+
+	// the code gives away the key, so it's only shown to players who
+	// can see it anyway
+	BoardCode string `json:"boardCode,omitempty"`
+
+	Replay *replayState `json:"replay,omitempty"`
+	Undo   undoState    `json:"undo"`
+	Series Series       `json:"series"`
 }
@@ -1,40 +1,239 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
 )
 
 func TestSelectWords(t *testing.T) {
 	out := map[string]struct{}{}
-	selectWords([]string{"a", "b", "c"}, 1, out)
+	selectWords(rand.New(rand.NewSource(1)), []string{"a", "b", "c"}, 1, out)
 
 	if len(out) != 1 {
 		log.Fatal("invalid number of words selected", out)
 	}
 }
-func TestIsSet(t *testing.T) {
-	if !isSet(BoardTypeDefault, BoardTypeDefault) {
-		t.Fatal("equality check failed")
+func TestWordPackRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "packs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	words := []string{}
+	for i := 0; i < 30; i++ {
+		words = append(words, fmt.Sprintf("word%d", i), fmt.Sprintf(" WORD%d ", i))
+	}
+	ioutil.WriteFile(dir+"/Animals.txt", []byte(strings.Join(words, "\n")), 0644)
+	ioutil.WriteFile(dir+"/tiny.txt", []byte("one\ntwo"), 0644)
+
+	registry := NewWordPackRegistry()
+	if err := registry.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	p, ok := registry.Get("animals")
+	if !ok || len(p.Words) != 30 || p.Words[0] != "WORD0" {
+		t.Fatal("pack was not loaded and cleaned up", p)
+	}
+	if _, ok := registry.Get("tiny"); ok {
+		t.Fatal("pack too small for a board was loaded")
+	}
+	if _, ok := wordPacks.Get("undercover"); !ok {
+		t.Fatal("built in packs are missing")
+	}
+}
+
+func TestUploadWordPack(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("p1", "player")
+
+	words := []string{}
+	for i := 0; i < 25; i++ {
+		words = append(words, fmt.Sprintf("upload%d", i))
+	}
+	if _, err := r.UploadWordPack("p1", "mine", words[:10]); err != ErrPackTooSmall {
+		t.Fatal("small pack was accepted", err)
+	}
+	if _, err := r.UploadWordPack("p1", "mine", append(words, strings.Repeat("x", maxWordLength+1))); err != ErrWordTooLong {
+		t.Fatal("long word was accepted", err)
+	}
+	p, err := r.UploadWordPack("p1", "Mine", words)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.ChangeCards("p1", "nope"); err != ErrUnknownPack {
+		t.Fatal("unknown pack was selected", err)
+	}
+	r.ChangeCards("p1", defaultPackID)
+	r.ChangeCards("p1", p.ID)
+	if len(r.WordPacks) != 1 || r.WordPacks[0] != p.ID {
+		t.Fatal("packs were not toggled", r.WordPacks)
+	}
+
+	r.NewGame("p1")
+	for _, row := range r.Game.Board {
+		for _, tile := range row {
+			if !strings.HasPrefix(tile.Word, "UPLOAD") {
+				t.Fatal("board was not dealt from the uploaded pack", tile.Word)
+			}
+		}
+	}
+}
+
+func TestPackWeights(t *testing.T) {
+	three := []*WordPack{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	if shares, _ := packShares(three, nil, boardSize); fmt.Sprint(shares) != "[9 8 8]" {
+		t.Fatal("unweighted packs were not split evenly", shares)
+	}
+	if shares, _ := packShares(three, map[string]int{"a": 50}, boardSize); fmt.Sprint(shares) != "[13 6 6]" {
+		t.Fatal("unweighted packs didn't share the rest", shares)
+	}
+	if _, err := packShares(three[:1], map[string]int{"a": 0}, boardSize); err != ErrNoPackWeight {
+		t.Fatal("packs without a share were accepted", err)
+	}
+
+	r := NewRoom("room", "pass")
+	r.Join("p1", "p1")
+	words := []string{}
+	for i := 0; i < 30; i++ {
+		words = append(words, fmt.Sprintf("upload%d", i))
+	}
+	p, err := r.UploadWordPack("p1", "mine", words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.ChangeCards("p1", p.ID)
+
+	if err := r.SetPackWeight("p1", p.ID, 101); err != ErrPackWeight {
+		t.Fatal("share above 100 percent was accepted", err)
 	}
-	if !isSet(BoardTypeDefault, BoardTypeDefault|BoardTypeCustom) {
-		t.Fatal("test for a combination of two item failed")
+	if err := r.SetPackWeight("p1", p.ID, 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetPackWeight("p1", defaultPackID, 80); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetPackWeight("p1", p.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetPackWeight("p1", defaultPackID, 0); err != ErrNoPackWeight {
+		t.Fatal("every pack was set to no share", err)
+	}
+	if err := r.SetPackWeight("p1", p.ID, 30); err != ErrWeightTotal || r.PackWeights[p.ID] != 0 {
+		t.Fatal("shares above 100 percent were accepted", err)
+	}
+	r.SetPackWeight("p1", p.ID, 20)
+
+	for i := 0; i < 20; i++ {
+		if err := r.NewGame("p1"); err != nil {
+			t.Fatal(err)
+		}
+		uploaded := 0
+		for _, row := range r.Game.Board {
+			for _, tile := range row {
+				if strings.HasPrefix(tile.Word, "UPLOAD") {
+					uploaded++
+				}
+			}
+		}
+		if uploaded != 5 {
+			t.Fatal("board didn't honor the pack shares", uploaded)
+		}
+	}
+
+	taken := map[string]struct{}{"elephant": {}}
+	if set := hardWords([]string{"elephant", "giraffe", "cat"}, 2, taken); fmt.Sprint(set) != "[giraffe cat]" {
+		t.Fatal("hard words fell back to words already on the board", set)
 	}
 
+	tiny := &WordPack{ID: "tiny", Name: "tiny", Words: words[:10]}
+	if _, err := boardWords(rand.New(rand.NewSource(1)), []*WordPack{tiny}, nil, DifficultyNormal); err == nil || err.(ActionError).Code != ErrWordsRanOut.Code {
+		t.Fatal("dry pack didn't fail", err)
+	}
+	r.RoomPacks[p.ID] = &WordPack{ID: p.ID, Name: p.Name, Words: words[:3]}
+	game := r.Game
+	if err := r.NewGame("p1"); err == nil || r.Game != game {
+		t.Fatal("board was replaced although a pack ran dry", err)
+	}
 }
-func TestSetsEnabled(t *testing.T) {
-	defaultSets := getTotalSetsEnabled(BoardTypeDefault)
-	if defaultSets != 1 {
-		t.Fatal("wrong set count for one set")
+
+func TestBoardCode(t *testing.T) {
+	for _, seed := range []int64{0, 1, 12345, 1<<40 - 1, newSeed()} {
+		code := boardCode(seed)
+		if len(code) != boardCodeLength {
+			t.Fatal("code has the wrong length", code)
+		}
+		if got, err := parseBoardCode(strings.ToLower(code)); err != nil || got != seed {
+			t.Fatal("code didn't give back the seed", code, got, err)
+		}
+	}
+	lookAlike, _ := parseBoardCode("0o1i-L000")
+	digits, _ := parseBoardCode("00111000")
+	if lookAlike != digits {
+		t.Fatal("look alike letters were not read as digits", lookAlike, digits)
+	}
+	if _, err := parseBoardCode("UUUUUUUU"); err != ErrBoardCode {
+		t.Fatal("invalid code was accepted", err)
+	}
+
+	words := []string{}
+	for i := 0; i < 25; i++ {
+		words = append(words, fmt.Sprintf("w%d", i))
+	}
+	pack, _ := NewWordPack("test", "test", words)
+	g, err := NewGame([]*WordPack{pack}, nil, DifficultyNormal, 0, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	layout := ""
+	for _, row := range g.Board {
+		for _, tile := range row {
+			layout += tile.Word + ":" + tile.Type[:1] + " "
+		}
+	}
+	expected := "W4:r W9:b W20:b W8:n W18:b W1:n W7:b W12:r W10:r W3:r W0:r W23:n W13:b W6:b W24:r W11:n W22:d W5:b W19:b W16:n W21:r W17:r W15:n W14:n W2:b "
+	if g.Turn != TeamBlue || layout != expected {
+		t.Fatal("seed dealt a different board", g.Turn, layout)
 	}
 
-	compundCount := getTotalSetsEnabled(BoardTypeCustom | BoardTypeDefault)
-	if compundCount != 2 {
-		t.Fatal("wrong set count for two sets", compundCount)
+	a := NewRoom("a", "pass")
+	b := NewRoom("b", "pass")
+	a.Join("host", "host")
+	b.Join("host", "host")
+	a.SwitchRole("host", PlayerRoleSpyMaster)
+	a.leaveLobby()
+	code := a.GameStateFor("host").BoardCode
+	if len(code) == 0 {
+		t.Fatal("spymaster didn't get the board code")
+	}
+	if len(a.GameStateFor("nobody").BoardCode) != 0 {
+		t.Fatal("spectator got the board code")
+	}
+	if err := b.NewGameFromCode("host", code); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(a.Game.Board) != fmt.Sprint(b.Game.Board) || a.Game.Turn != b.Game.Turn {
+		t.Fatal("board code dealt a different board")
 	}
 }
 
 func TestBoardGeneration(t *testing.T) {
-	tiles := generateBoard(BoardTypeDefault, TeamBlue)
+	tiles, err := generateBoard(rand.New(rand.NewSource(1)), NewRoom("room", "pass").selectedPacks(), nil, DifficultyNormal, TeamBlue)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if len(tiles) != 5 {
 		t.Fatal("board doesn't have 5 rows", len(tiles))
@@ -47,3 +246,1597 @@ func TestBoardGeneration(t *testing.T) {
 		}
 	}
 }
+
+func TestHardDifficulty(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("red", "red")
+	r.Join("redmaster", "redmaster")
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+
+	if err := r.ChangeDifficulty("red", "impossible"); err != ErrInvalidSetting {
+		t.Fatal("unknown difficulty was accepted", err)
+	}
+	if err := r.ChangeDifficulty("red", DifficultyHard); err != nil {
+		t.Fatal(err)
+	}
+	if r.Game.Difficulty != DifficultyHard {
+		t.Fatal("unplayed board was not dealt again")
+	}
+
+	counts := map[string]int{}
+	for _, row := range r.Game.Board {
+		for _, tile := range row {
+			counts[tile.Type]++
+			if len(tile.Word) < hardWordLength {
+				t.Fatal("short word on a hard board", tile.Word)
+			}
+		}
+	}
+	if counts[TileTypeBlack] != 3 || counts[TileTypeNeutral] != 5 {
+		t.Fatal("hard board has the wrong layout", counts)
+	}
+
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.Game.Turn = TeamRed
+	r.leaveLobby()
+	if err := r.DeclareClue("redmaster", "zzyzx", ClueCountUnlimited); err == nil {
+		t.Fatal("unlimited clue was accepted on hard")
+	}
+	if err := r.DeclareClue("redmaster", "zzyzx", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			if tile.Type == TileTypeRed {
+				r.SelectTile("red", i, j)
+				break
+			}
+		}
+		if r.Game.Turn != TeamRed {
+			break
+		}
+	}
+	if r.Game.Turn != TeamBlue {
+		t.Fatal("hard game gave a bonus guess")
+	}
+	if n := len(r.GameStateFor("redmaster").Game.Log); n != 1 {
+		t.Fatal("spymaster can see the guesses", n)
+	}
+	if n := len(r.GameStateFor("red").Game.Log); n != 2 {
+		t.Fatal("guesser should see the whole log", n)
+	}
+}
+
+func TestDuetKeys(t *testing.T) {
+	red, blue := generateDuetKeys(rand.New(rand.NewSource(1)))
+	if len(red) != 25 || len(blue) != 25 {
+		t.Fatal("key cards don't have 25 tiles", len(red), len(blue))
+	}
+
+	count := func(key []string, typ string) int {
+		n := 0
+		for _, k := range key {
+			if k == typ {
+				n++
+			}
+		}
+		return n
+	}
+	for _, key := range [][]string{red, blue} {
+		if count(key, TileTypeGreen) != 9 {
+			t.Fatal("side doesn't have 9 greens", key)
+		}
+		if count(key, TileTypeBlack) != 3 {
+			t.Fatal("side doesn't have 3 assassins", key)
+		}
+	}
+
+	greens := 0
+	for i := range red {
+		if red[i] == TileTypeGreen || blue[i] == TileTypeGreen {
+			greens++
+		}
+	}
+	if greens != duetGreens {
+		t.Fatal("wrong number of distinct greens", greens)
+	}
+}
+
+func TestDuetGameState(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("p1", "red player")
+	r.Join("p2", "blue player")
+	r.ChangeTeam("p1", TeamRed)
+	r.ChangeTeam("p2", TeamBlue)
+	r.SwitchGameType("p1", GameTypeDuet)
+
+	if r.Game.GameType != GameTypeDuet {
+		t.Fatal("game type was not switched", r.Game.GameType)
+	}
+	r.leaveLobby()
+
+	redView := r.GameStateFor("p1").Game.Board
+	for i := range redView {
+		for j := range redView[i] {
+			if redView[i][j].Type != r.Game.duetKeyType(TeamRed, i, j) {
+				t.Fatal("red player sees the wrong key at", i, j)
+			}
+			if r.Game.Board[i][j].Type != "" {
+				t.Fatal("shared board leaked a tile type at", i, j)
+			}
+		}
+	}
+
+	r.Game.Greens = 0
+	data, err := json.Marshal(r.GameStateFor("p1"))
+	if err != nil || !bytes.Contains(data, []byte(`"greens":0`)) {
+		t.Fatal("no greens left was not sent", err)
+	}
+}
+
+func TestGameStateHidesKeyFromGuessers(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("guesser", "guesser")
+	r.Join("spymaster", "spymaster")
+	r.SwitchRole("spymaster", PlayerRoleSpyMaster)
+	r.Game.Board[0][0].Flipped = true
+
+	if board := r.GameStateFor("spymaster").Game.Board; board[0][1].Type != "" {
+		t.Fatal("spymaster saw the key before the game started")
+	}
+	r.leaveLobby()
+
+	for _, id := range []string{"guesser", "nobody"} {
+		board := r.GameStateFor(id).Game.Board
+		if board[0][0].Type != r.Game.Board[0][0].Type {
+			t.Fatal("flipped tile type was hidden from", id)
+		}
+		if board[0][1].Type != "" {
+			t.Fatal("unflipped tile type was sent to", id)
+		}
+	}
+
+	board := r.GameStateFor("spymaster").Game.Board
+	if board[0][1].Type != r.Game.Board[0][1].Type {
+		t.Fatal("spymaster did not receive the key card")
+	}
+}
+
+func TestFileRoomStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rooms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileRoomStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewRoom("room/with slash", "hunter2")
+	r.Join("p1", "player")
+	r.ChangeTimer("p1", 2)
+	r.ChangeCards("p1", "nsfw")
+	r.Game.turnsTaken = 2
+	word := r.Game.Board[0][0].Word
+	r.Players["p1"].GuessProposal = &word
+	r.Players["p1"].proposedAt = time.Now().Add(-time.Second)
+	r.pauseVotes = buildSet("p1")
+	r.History = []*GameRecord{r.Game.Record()}
+	if err := store.Save(r); err != nil {
+		t.Fatal(err)
+	}
+
+	rooms, err := NewFileRoomStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := rooms.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 1 {
+		t.Fatal("expected one room", len(loaded))
+	}
+
+	l := loaded[0]
+	if l.Name != r.Name || l.Players["p1"].NickName != "player" {
+		t.Fatal("room was not restored", l)
+	}
+	if len(l.WordPacks) != 2 || l.timerAmount != r.timerAmount || l.Game.turnsTaken != 2 {
+		t.Fatal("room state was not restored", l.WordPacks, l.timerAmount, l.Game.turnsTaken)
+	}
+	if l.Game.Board[2][2].Word != r.Game.Board[2][2].Word || l.Game.Board[2][2].Type != r.Game.Board[2][2].Type {
+		t.Fatal("board was not restored")
+	}
+	if !l.Players["p1"].proposedAt.Equal(r.Players["p1"].proposedAt) {
+		t.Fatal("proposal time was not restored", l.Players["p1"].proposedAt)
+	}
+	if _, ok := l.pauseVotes["p1"]; !ok || len(l.undoVotes) != 0 {
+		t.Fatal("votes were not restored", l.pauseVotes, l.undoVotes)
+	}
+	if len(l.History) != 1 || l.History[0].BoardCode != r.History[0].BoardCode {
+		t.Fatal("history was not restored", l.History)
+	}
+	if !checkPassword(l.Password, "hunter2") || checkPassword(l.Password, "hunter3") {
+		t.Fatal("password was not restored")
+	}
+	data, err := ioutil.ReadFile(store.path(r.Name))
+	if err != nil || bytes.Contains(data, []byte(`"history"`)) {
+		t.Fatal("history should be saved on its own", err)
+	}
+	if bytes.Contains(data, []byte("hunter2")) {
+		t.Fatal("password was saved in plain text")
+	}
+
+	// rooms saved before passwords were hashed
+	var snap roomSnapshot
+	json.Unmarshal([]byte(`{"room":"old","password":"hunter2"}`), &snap)
+	if old := snap.restore(); !checkPassword(old.Password, "hunter2") {
+		t.Fatal("plain text password was not hashed", old.Password)
+	}
+
+	if err := store.Delete(r.Name); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, _ := store.Load(); len(loaded) != 0 {
+		t.Fatal("room was not deleted")
+	}
+}
+
+func TestReconnectGrace(t *testing.T) {
+	a := NewActionRouter(RouterConfig{
+		ReconnectGrace: 30 * time.Millisecond,
+		EmptyRoomGrace: 200 * time.Millisecond,
+	})
+	a.CreateRoom("p1", "", "player", "room", "pass", ResEmitFunc(func(msg string, success bool) {
+		if !success {
+			t.Fatal("could not create room", msg)
+		}
+	}))
+
+	a.Disconnect("p1")
+	away := make(chan bool)
+	a.RoomForPlayer("p1", func(r *Room) { away <- r.Players["p1"].Away })
+	if !<-away {
+		t.Fatal("disconnected player was not marked away")
+	}
+
+	a.Reconnect("p1", func(r *Room) { away <- r.Players["p1"].Away })
+	if <-away {
+		t.Fatal("reconnected player is still away")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if a.Players() != 1 {
+		t.Fatal("reconnected player was removed")
+	}
+
+	a.Disconnect("p1")
+	time.Sleep(80 * time.Millisecond)
+	if a.Players() != 0 {
+		t.Fatal("player was not removed after the grace period")
+	}
+	if a.Rooms() != 1 {
+		t.Fatal("empty room was closed before the grace period")
+	}
+
+	time.Sleep(250 * time.Millisecond)
+	if a.Rooms() != 0 {
+		t.Fatal("empty room was not closed")
+	}
+}
+
+// countingStore counts the rooms saved by the router
+type countingStore struct {
+	nopRoomStore
+	saves chan string
+}
+
+func (s countingStore) Save(r *Room) error {
+	s.saves <- r.Name
+	return nil
+}
+
+func TestSaveDelay(t *testing.T) {
+	store := countingStore{saves: make(chan string, 10)}
+	a := NewActionRouter(RouterConfig{Store: store, SaveDelay: 30 * time.Millisecond})
+	a.CreateRoom("p1", "", "player", "room", "pass", ResEmitFunc(func(string, bool) {}))
+	for i := 0; i < 5; i++ {
+		ready := i%2 == 0
+		a.RoomForPlayer("p1", func(r *Room) { r.SetReady("p1", ready) })
+	}
+
+	select {
+	case <-store.saves:
+		t.Fatal("room was saved before the save delay")
+	case <-time.After(10 * time.Millisecond):
+	}
+	select {
+	case <-store.saves:
+	case <-time.After(time.Second):
+		t.Fatal("changed room was not saved")
+	}
+	select {
+	case <-store.saves:
+		t.Fatal("changes made together were saved more than once")
+	case <-time.After(60 * time.Millisecond):
+	}
+}
+
+func TestCreateSecondRoom(t *testing.T) {
+	a := NewActionRouter(RouterConfig{})
+	created := func(msg string, success bool) {
+		if !success {
+			t.Fatal("could not create room", msg)
+		}
+	}
+	a.CreateRoom("p1", "", "player", "first", "pass", ResEmitFunc(created))
+	first := a.RoomNameReceiver("first")
+	a.CreateRoom("p1", "", "player", "second", "pass", ResEmitFunc(created))
+
+	// leaving the first room closes it, the player stays in the second
+	a.InspectRoom("first", func(r *Room) {})
+	if rr := a.PlayerRoomReceiver("p1"); rr == nil || rr != a.RoomNameReceiver("second") {
+		t.Fatal("player lost their new room")
+	}
+	if a.Rooms() != 1 {
+		t.Fatal("empty room was not closed", a.Rooms())
+	}
+	if first.send(func(r *Room) {}) {
+		t.Fatal("closed room took an action")
+	}
+}
+
+func TestAfkTracker(t *testing.T) {
+	kicked := make(chan string, 1)
+	afk := newAfkTracker(20*time.Millisecond, func(string) {}, func(playerID string) {
+		kicked <- playerID
+	})
+
+	afk.Touch("p1")
+	time.Sleep(10 * time.Millisecond)
+	afk.Touch("p1")
+
+	select {
+	case <-kicked:
+		t.Fatal("player was kicked even though they were active")
+	case <-time.After(15 * time.Millisecond):
+	}
+
+	select {
+	case id := <-kicked:
+		if id != "p1" {
+			t.Fatal("wrong player kicked", id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("inactive player was not kicked")
+	}
+
+	// a touch that got the lock just before the kick fired wins
+	afk.Touch("p2")
+	time.Sleep(10 * time.Millisecond)
+	afk.Lock()
+	afk.lastSeen["p2"] = time.Now()
+	afk.Unlock()
+	select {
+	case <-kicked:
+		t.Fatal("player was kicked right after they were active")
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestHostModeration(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("host", "host")
+	r.Join("p2", "second")
+	r.Players["p2"].JoinedAt = r.Players["host"].JoinedAt.Add(time.Second)
+	r.Join("p3", "third")
+	r.Players["p3"].JoinedAt = r.Players["host"].JoinedAt.Add(2 * time.Second)
+
+	if !r.IsHost("host") {
+		t.Fatal("first player is not the host", r.Host)
+	}
+	if err := r.Kick("p2", "p3", false); err != ErrNotHost {
+		t.Fatal("non host was allowed to kick", err)
+	}
+	r.Players["p3"].addr = "10.0.0.3"
+	if err := r.Kick("host", "p3", true); err != nil || !r.IsBanned("p3", "") {
+		t.Fatal("host could not ban player", err)
+	}
+	if !r.IsBanned(sessionPlayerID(newSessionToken()), "10.0.0.3") {
+		t.Fatal("banned player got back in with a new session")
+	}
+	if r.IsBanned("p4", "10.0.0.4") || r.IsBanned("p4", "") {
+		t.Fatal("player who wasn't banned is kept out")
+	}
+
+	if err := r.LockSettings("host", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ChangeDifficulty("p2", DifficultyHard); err != ErrSettingsLocked || r.Difficulty != DifficultyNormal {
+		t.Fatal("locked settings were changed by a non host")
+	}
+
+	r.Leave("host")
+	if !r.IsHost("p2") {
+		t.Fatal("host was not handed over to the longest present player", r.Host)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	token, id := connectSession("")
+	if token == "" || token == id || strings.Contains(id, token) {
+		t.Fatal("public id gives away the session token", token, id)
+	}
+	if again, sameID := connectSession(token); again != token || sameID != id {
+		t.Fatal("reconnecting with the token didn't keep the player", again, sameID)
+	}
+	if _, other := connectSession(id); other == id {
+		t.Fatal("the public id can be used as a session token")
+	}
+	if other, _ := connectSession("null"); other == "null" {
+		t.Fatal("a missing session was reused")
+	}
+	if remoteHost("10.0.0.1:4242") != "10.0.0.1" || remoteHost("[::1]:80") != "::1" {
+		t.Fatal("port was not stripped from the address")
+	}
+}
+
+func TestGameReplay(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("red", "red")
+	r.Join("redmaster", "redmaster")
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.Game.Turn = TeamRed
+	r.Game.record.Turn = TeamRed
+	r.leaveLobby()
+
+	if _, err := r.ExportGame("red"); err != ErrNoGameRecord {
+		t.Fatal("unplayed game was exported", err)
+	}
+
+	var red, neutral Position
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			switch tile.Type {
+			case TileTypeRed:
+				red = Position{i, j}
+			case TileTypeNeutral:
+				neutral = Position{i, j}
+			}
+		}
+	}
+	r.DeclareClue("redmaster", "clue", 2)
+	r.SelectTile("red", red.I, red.J)
+	r.SelectTile("red", neutral.I, neutral.J)
+	board := fmt.Sprint(r.Game.Board)
+	turn := r.Game.Turn
+
+	last := r.Game.Log[len(r.Game.Log)-1]
+	if last.Player != "red" || last.Tile == nil || *last.Tile != neutral || last.Time.IsZero() {
+		t.Fatal("guess was logged without its actor, tile or time", last)
+	}
+
+	r.NewGame("red")
+	if len(r.History) != 1 || len(r.Game.Log) != 0 {
+		t.Fatal("played game was not kept", len(r.History))
+	}
+	rec, err := r.ExportGame("red")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported GameRecord
+	if err := json.Unmarshal(data, &imported); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&GameRecord{Version: gameRecordVersion}).validate(); err != ErrInvalidRecord {
+		t.Fatal("empty record was accepted", err)
+	}
+	if err := imported.validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay := NewRoom("replay", "pass")
+	replay.startReplay(&imported)
+	replay.Join("host", "host")
+	replay.Join("viewer", "viewer")
+	replay.Host = "host"
+	if replay.Game.Board[red.I][red.J].Flipped || replay.Game.Turn != TeamRed {
+		t.Fatal("replay didn't start from the dealt board")
+	}
+	if err := replay.ReplayStep("viewer", 1); err != ErrNotHost {
+		t.Fatal("viewer stepped through the replay", err)
+	}
+	if err := replay.ReplayStep("host", 4); err != ErrInvalidStep {
+		t.Fatal("stepped past the end of the game", err)
+	}
+	if err := replay.ReplayStep("host", 2); err != nil {
+		t.Fatal(err)
+	}
+	if !replay.Game.Board[red.I][red.J].Flipped || replay.Game.Board[neutral.I][neutral.J].Flipped {
+		t.Fatal("replay is not at the second event")
+	}
+	replay.ReplayStep("host", 3)
+	if fmt.Sprint(replay.Game.Board) != board || replay.Game.Turn != turn {
+		t.Fatal("replay ended on a different board")
+	}
+	if gs := replay.GameStateFor("viewer"); gs.Replay == nil || gs.Replay.Steps != 3 {
+		t.Fatal("replay progress is missing from the game state", gs.Replay)
+	}
+
+	if err := replay.SwitchRole("host", PlayerRoleSpyMaster); err != ErrReplayRoom {
+		t.Fatal("role changed in a replay room", err)
+	}
+	if err := replay.NewGame("host"); err != ErrReplayRoom {
+		t.Fatal("replay room started a game", err)
+	}
+	if err := replay.DeclareClue("host", "clue", 1); err != ErrReplayRoom {
+		t.Fatal("clue was given in a replay room", err)
+	}
+}
+
+func TestUndo(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("host", "host")
+	r.Join("red", "red")
+	r.Join("redmaster", "redmaster")
+	r.Join("blue", "blue")
+	r.Join("watcher", "watcher")
+	r.Host = "host"
+	r.ChangeTeam("host", TeamBlue)
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.ChangeTeam("blue", TeamBlue)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.SwitchRole("watcher", PlayerRoleSpectator)
+	r.Game.Turn = TeamRed
+	r.leaveLobby()
+
+	if err := r.Undo("red"); err != ErrNothingToUndo {
+		t.Fatal("undo without a move", err)
+	}
+
+	var neutral Position
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			if tile.Type == TileTypeNeutral {
+				neutral = Position{i, j}
+			}
+		}
+	}
+	r.DeclareClue("redmaster", "clue", 1)
+	r.SelectTile("red", neutral.I, neutral.J)
+	if r.Game.Turn != TeamBlue {
+		t.Fatal("neutral tile didn't end the turn")
+	}
+
+	if err := r.Undo("watcher"); err != ErrSpectator {
+		t.Fatal("spectator voted to undo", err)
+	}
+	r.Undo("red")
+	r.Undo("blue")
+	if !r.Game.Board[neutral.I][neutral.J].Flipped || len(r.GameStateFor("red").Undo.Votes) != 2 {
+		t.Fatal("move was taken back without a majority")
+	}
+	r.Undo("redmaster")
+	if r.Game.Board[neutral.I][neutral.J].Flipped || r.Game.Turn != TeamRed || r.Game.Clue == nil {
+		t.Fatal("guess was not taken back")
+	}
+
+	last := r.Game.Log[len(r.Game.Log)-1]
+	if last.Event != "undo" || !r.Game.Log[len(r.Game.Log)-2].Undone {
+		t.Fatal("undo was not logged", r.Game.Log)
+	}
+	if n := len(r.GameStateFor("red").Game.Log); n != 1 {
+		t.Fatal("taken back moves are still shown", n)
+	}
+
+	if err := r.Undo("host"); err != nil || r.Game.Clue != nil {
+		t.Fatal("host could not take back the clue", err)
+	}
+
+	r.DeclareClue("redmaster", "other", 1)
+	replay := NewRoom("replay", "pass")
+	rec := r.Game.Record()
+	replay.startReplay(rec)
+	replay.replayTo(len(rec.Log))
+	if fmt.Sprint(replay.Game.Board) != fmt.Sprint(r.Game.Board) || replay.Game.Clue.Word != "other" {
+		t.Fatal("replay didn't skip the moves taken back")
+	}
+}
+
+func TestStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileStatsStore(dir + "/stats.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := NewStats(store)
+
+	r := NewRoom("room", "pass")
+	r.Join("red", "red")
+	r.Join("redmaster", "redmaster")
+	r.Join("blue", "blue")
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.ChangeTeam("blue", TeamBlue)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.Game.Turn = TeamRed
+	r.leaveLobby()
+
+	var red, assassin Position
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			switch tile.Type {
+			case TileTypeRed:
+				red = Position{i, j}
+			case TileTypeBlack:
+				assassin = Position{i, j}
+			}
+		}
+	}
+	r.DeclareClue("redmaster", "clue", 3)
+	r.SelectTile("red", red.I, red.J)
+	if results, _ := r.takeResults(); len(results) != 0 {
+		t.Fatal("result was recorded before the game ended")
+	}
+	r.SelectTile("red", assassin.I, assassin.J)
+	results, _ := r.takeResults()
+	if len(results) != 1 {
+		t.Fatal("game result was not recorded", results)
+	}
+	stats.Record(results[0])
+	stats.Record(results[0])
+
+	board := NewStats(store).Leaderboard("room")
+	if board.Games != 1 || board.TeamWins[TeamBlue] != 1 || len(board.Players) != 3 {
+		t.Fatal("stats were not kept", board)
+	}
+	top := board.Players[0]
+	if top.Nickname != "blue" || top.Wins != 1 || top.GuesserWins != 1 {
+		t.Fatal("winner is not ranked first", top)
+	}
+	for _, ps := range board.Players {
+		switch ps.Nickname {
+		case "red":
+			if ps.AssassinHits != 1 || ps.Guesses != 2 || ps.Accuracy != 0.5 || ps.GuesserLosses != 1 {
+				t.Fatal("guesser stats are wrong", ps)
+			}
+		case "redmaster":
+			if ps.SpymasterLosses != 1 || ps.Clues != 1 || ps.AverageClue != 3 {
+				t.Fatal("spymaster stats are wrong", ps)
+			}
+		}
+	}
+	if board := stats.Leaderboard("other"); board.Games != 0 {
+		t.Fatal("stats of another room were counted", board)
+	}
+
+	// taking back the assassin takes back the result
+	if err := r.Undo("red"); err != nil {
+		t.Fatal(err)
+	}
+	_, undone := r.takeResults()
+	if len(undone) != 1 || undone[0] != results[0].GameID {
+		t.Fatal("undone game was not taken out of the stats", undone)
+	}
+	stats.Forget(undone[0])
+	if board := NewStats(store).Leaderboard("room"); board.Games != 0 {
+		t.Fatal("stats kept the result of an undone game", board)
+	}
+}
+
+func TestSeries(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c", "d"} {
+		r.Join(id, id)
+	}
+	r.Host = "a"
+	r.ChangeTeam("a", TeamRed)
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamBlue)
+	r.ChangeTeam("d", TeamBlue)
+
+	if err := r.ChangeSeriesSettings("a", SeriesSettings{BestOf: 2}); err != ErrBestOf {
+		t.Fatal("even series length was accepted", err)
+	}
+	if err := r.ChangeSeriesSettings("a", SeriesSettings{BestOf: 3, AlternateStart: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ChangeRolePolicy("a", RolePolicyRoundRobin); err != nil {
+		t.Fatal(err)
+	}
+
+	spymaster := func(team string) *Player {
+		for _, p := range r.teamPlayers(team) {
+			if p.Role == PlayerRoleSpyMaster {
+				return p
+			}
+		}
+		t.Fatal("team has no spymaster", team)
+		return nil
+	}
+	// the team playing hits the assassin, so the other team wins
+	loseTurn := func() string {
+		if !r.gameStarted() {
+			r.leaveLobby()
+		}
+		team := r.Game.Turn
+		r.DeclareClue(spymaster(team).ID, "clue", 1)
+		for _, p := range r.teamPlayers(team) {
+			if p.Role == PlayerRoleGuesser {
+				for i, row := range r.Game.Board {
+					for j, tile := range row {
+						if tile.Type == TileTypeBlack {
+							r.SelectTile(p.ID, i, j)
+						}
+					}
+				}
+				break
+			}
+		}
+		if !r.Game.Over {
+			t.Fatal("game didn't end")
+		}
+		return otherTeam(team)
+	}
+
+	r.NewGame("a")
+	first := r.Game.Turn
+	red, blue := spymaster(TeamRed).ID, spymaster(TeamBlue).ID
+	winner := loseTurn()
+
+	r.NewGame("a")
+	if r.Game.Turn != otherTeam(first) || r.Game.tilesLeft(r.Game.Turn) != 9 {
+		t.Fatal("the other team didn't start with the extra tile", r.Game.Turn)
+	}
+	if spymaster(TeamRed).ID == red || spymaster(TeamBlue).ID == blue {
+		t.Fatal("spymasters didn't rotate")
+	}
+	r.ChangeDifficulty("a", DifficultyHard)
+	r.ChangeDifficulty("a", DifficultyNormal)
+	if r.Game.Turn != otherTeam(first) {
+		t.Fatal("dealing the board again switched the starting team")
+	}
+	dealt, _ := NewGame(r.selectedPacks(), r.PackWeights, r.Difficulty, r.timerAmount, r.Game.seed)
+	if fmt.Sprint(dealt.Board) != fmt.Sprint(r.Game.Board) || dealt.Turn != r.Game.Turn {
+		t.Fatal("board code doesn't deal the board of an alternated game")
+	}
+	if r.Series.Games != 1 || r.GameStateFor("a").Series.Games != 1 {
+		t.Fatal("finished game was not counted", r.Series)
+	}
+
+	loseTurn()
+	r.Undo("a")
+	if r.Series.Games != 1 || r.Game.Over {
+		t.Fatal("taken back game end is still counted", r.Series)
+	}
+	loseTurn()
+	if r.Series.Red != 1 || r.Series.Blue != 1 || r.Series.Winner != nil {
+		t.Fatal("series score is wrong", r.Series)
+	}
+
+	r.NewGame("a")
+	loseTurn()
+	if r.Series.Winner == nil || *r.Series.Winner != winner {
+		t.Fatal("best of 3 series was not decided", r.Series)
+	}
+	r.NewGame("a")
+	if r.Series.Winner != nil || r.Series.Games != 0 {
+		t.Fatal("series after a decided one didn't start from 0", r.Series)
+	}
+}
+
+func TestRolePolicy(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c"} {
+		r.Join(id, id)
+	}
+	r.Host = "a"
+	r.ChangeTeam("a", TeamRed)
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamRed)
+
+	if err := r.ChangeRolePolicy("a", "lottery"); err != ErrInvalidSetting {
+		t.Fatal("unknown policy was accepted", err)
+	}
+	r.SwitchRole("b", PlayerRoleSpyMaster)
+	r.NewGame("a")
+	if r.Players["b"].Role != PlayerRoleSpyMaster {
+		t.Fatal("manual policy changed the roles")
+	}
+
+	r.ChangeRolePolicy("a", RolePolicyRandom)
+	game := r.Game
+	if err := r.NewGame("a"); err != ErrNoEligiblePlayer || r.Game != game {
+		t.Fatal("game started without a blue spymaster", err)
+	}
+
+	r.ChangeTeam("c", TeamBlue)
+	r.Chat = nil
+	if err := r.NewGame("a"); err != nil {
+		t.Fatal(err)
+	}
+	spymasters := 0
+	for _, p := range r.teamPlayers(TeamRed) {
+		if p.Role == PlayerRoleSpyMaster {
+			spymasters++
+		}
+	}
+	if spymasters != 1 || r.Players["c"].Role != PlayerRoleSpyMaster {
+		t.Fatal("teams don't have exactly one spymaster")
+	}
+	if len(r.Chat) != 2 || !r.Chat[0].System || len(r.takeAnnouncements()) != 2 {
+		t.Fatal("spymasters were not announced", r.Chat)
+	}
+
+	r.SetAway("c", true)
+	if err := r.NewGame("a"); err != ErrNoEligiblePlayer {
+		t.Fatal("player who is away was made spymaster", err)
+	}
+}
+
+func TestPauseGame(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c"} {
+		r.Join(id, id)
+	}
+	r.Host = "a"
+	r.Mode = ModeTimed
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamBlue)
+	r.SwitchRole("b", PlayerRoleSpyMaster)
+	r.SwitchRole("c", PlayerRoleSpyMaster)
+	spymaster := "b"
+	if r.Game.Turn == TeamBlue {
+		spymaster = "c"
+	}
+	r.leaveLobby()
+
+	r.PauseGame("b")
+	if r.Paused != nil || len(r.GameStateFor("a").PauseVotes) != 1 {
+		t.Fatal("one team paused the game on its own")
+	}
+	r.PauseGame("c")
+	if r.Paused == nil || len(r.Paused.By) != 2 || r.GameStateFor("a").Paused == nil {
+		t.Fatal("game was not paused when both teams agreed")
+	}
+
+	timer := r.Game.Timer
+	if r.TimerTick(); r.Game.Timer != timer {
+		t.Fatal("paused timer ticked")
+	}
+	if err := r.DeclareClue(spymaster, "clue", 1); err != ErrGamePaused {
+		t.Fatal("clue was given in a paused game", err)
+	}
+
+	if err := r.ResumeGame("a"); err != nil || r.Paused != nil {
+		t.Fatal("host could not resume the game", err)
+	}
+	if err := r.ResumeGame("a"); err != ErrNotPaused {
+		t.Fatal("game that wasn't paused was resumed", err)
+	}
+	if err := r.DeclareClue(spymaster, "clue", 1); err != nil {
+		t.Fatal(err)
+	}
+	if r.TimerTick(); r.Game.Timer != timer-1 {
+		t.Fatal("resumed timer didn't tick")
+	}
+
+	r.PauseGame("a")
+	if err := r.Undo("a"); err != ErrGamePaused || r.Game.Clue == nil {
+		t.Fatal("move was taken back in a paused game", err)
+	}
+}
+
+func TestTimerSettings(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c"} {
+		r.Join(id, id)
+	}
+	r.Host = "a"
+	r.Mode = ModeTimed
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamBlue)
+	r.SwitchRole("b", PlayerRoleSpyMaster)
+	r.SwitchRole("c", PlayerRoleSpyMaster)
+
+	if err := r.ChangeTimerSettings("a", TimerSettings{ClueSeconds: -1}); err != ErrInvalidSetting {
+		t.Fatal("negative timer was accepted", err)
+	}
+	if err := r.ChangeTimerSettings("a", TimerSettings{ClueSeconds: 3, GuessSeconds: 5, BankSeconds: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Game.Timer != 3 || r.Game.Bank[TeamRed] != 10 {
+		t.Fatal("settings were not applied to the new board", r.Game.Timer, r.Game.Bank)
+	}
+	r.leaveLobby()
+
+	first := r.Game.Turn
+	spymaster := map[string]string{TeamRed: "b", TeamBlue: "c"}
+	r.TimerTick()
+	r.DeclareClue(spymaster[first], "clue", 1)
+	if r.Game.Timer != 5 {
+		t.Fatal("guessers didn't get their own time", r.Game.Timer)
+	}
+	for i := 0; i < 5; i++ {
+		r.TimerTick()
+	}
+	if r.Game.Turn == first || r.Game.Timer != 3 || r.Game.Bank[first] != 4 {
+		t.Fatal("turn didn't time out to the other team's clue", r.Game.Turn, r.Game.Timer, r.Game.Bank)
+	}
+
+	if err := r.ChangeTimer("a", -1); err != ErrInvalidSetting {
+		t.Fatal("negative turn length was accepted", err)
+	}
+	if err := r.ChangeTimer("a", 2); err != nil || r.Game.Timer != 3 {
+		t.Fatal("changing the turn length reset the running clock", err, r.Game.Timer)
+	}
+
+	// the first team has the smaller bank left and runs out first
+	for i := 0; i < 20 && !r.Game.Over; i++ {
+		r.TimerTick()
+	}
+	if !r.Game.Over || r.Game.Winner == nil || *r.Game.Winner != otherTeam(first) {
+		t.Fatal("team that used up its bank didn't lose", r.Game.Winner)
+	}
+	if r.TimerTick() {
+		t.Fatal("timer ticked after the game ended")
+	}
+}
+
+func TestGamePhase(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c", "d"} {
+		r.Join(id, id)
+	}
+	r.ChangeTeam("a", TeamRed)
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamBlue)
+	r.ChangeTeam("d", TeamBlue)
+	r.SwitchRole("a", PlayerRoleSpyMaster)
+	r.SwitchRole("c", PlayerRoleSpyMaster)
+	r.startGame(seedStartedBy(TeamRed))
+
+	if r.Game.Phase != PhaseLobby || r.GameStateFor("b").Game.Phase != PhaseLobby {
+		t.Fatal("new game didn't start in the lobby", r.Game.Phase)
+	}
+	r.leaveLobby()
+	if r.Game.Phase != PhaseAwaitingClue {
+		t.Fatal("started game isn't waiting for a clue", r.Game.Phase)
+	}
+	if err := r.SelectTile("b", 0, 0); err != ErrNoClue {
+		t.Fatal("tile was flipped before the clue", err)
+	}
+	if err := r.DeclareClue("a", "clue", 2); err != nil || r.Game.Phase != PhaseGuessing {
+		t.Fatal("clue didn't start the guessing", err, r.Game.Phase)
+	}
+	if err := r.DeclareClue("a", "other", 2); err != ErrClueGiven {
+		t.Fatal("second clue was accepted", err)
+	}
+	if err := r.DeclareClue("a", r.Game.Board[0][0].Word, 2); err != ErrClueGiven {
+		t.Fatal("clue out of turn was checked against the rules first", err)
+	}
+	if err := r.Game.checkPhase(PhaseGuessing); err != ErrPhaseTransition {
+		t.Fatal("table allows guessing twice", err)
+	}
+
+	r.EndTurn("b")
+	if r.Game.Phase != PhaseAwaitingClue || r.Game.Turn != TeamBlue {
+		t.Fatal("ending the turn didn't wait for the next clue", r.Game.Phase)
+	}
+
+	r.DeclareClue("c", "clue", 1)
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			if tile.Type == TileTypeBlack {
+				r.SelectTile("d", i, j)
+			}
+		}
+	}
+	if r.Game.Phase != PhaseGameOver || !r.Game.Over {
+		t.Fatal("assassin didn't end the game", r.Game.Phase)
+	}
+	if err := r.Game.checkPhase(PhaseAwaitingClue); err != ErrPhaseTransition {
+		t.Fatal("finished game can go on", err)
+	}
+
+	saved := *newGameSnapshot(r.Game)
+	saved.Phase = ""
+	if saved.restore().Phase != PhaseGameOver {
+		t.Fatal("phase of a game saved without one is wrong")
+	}
+}
+
+func TestLobby(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		r.Join(id, id)
+	}
+	r.Host = "a"
+	r.ChangeTeam("a", TeamRed)
+	r.ChangeTeam("b", TeamRed)
+	r.ChangeTeam("c", TeamBlue)
+	r.ChangeTeam("d", TeamBlue)
+	r.SwitchRole("a", PlayerRoleSpyMaster)
+	r.SwitchRole("e", PlayerRoleSpectator)
+
+	if len(r.GameStateFor("b").Game.Board) != 5 {
+		t.Fatal("lobby has no board")
+	}
+	if err := r.DeclareClue("a", "clue", 1); err != ErrInLobby {
+		t.Fatal("clue was given in the lobby", err)
+	}
+
+	for _, id := range []string{"a", "b", "c", "d"} {
+		r.SetReady(id, true)
+	}
+	if r.gameStarted() {
+		t.Fatal("game started without a blue spymaster")
+	}
+	r.SwitchRole("c", PlayerRoleSpyMaster)
+	if r.Players["c"].Ready || r.gameStarted() {
+		t.Fatal("changing roles kept the player ready")
+	}
+	if err := r.SetReady("e", true); err != ErrSpectator {
+		t.Fatal("spectator got ready", err)
+	}
+	r.SetReady("c", true)
+	if !r.gameStarted() || r.Players["a"].Ready {
+		t.Fatal("game didn't start once everyone was ready")
+	}
+	if err := r.SetReady("a", true); err != ErrGameStarted {
+		t.Fatal("player got ready in a running game", err)
+	}
+
+	r.Game.end(nil)
+	r.NewGame("a")
+	if r.gameStarted() {
+		t.Fatal("new game didn't wait in the lobby")
+	}
+	if err := r.ForceStart("b"); err != ErrNotHost {
+		t.Fatal("guest forced the start", err)
+	}
+	if err := r.ForceStart("a"); err != nil || !r.gameStarted() {
+		t.Fatal("host could not force the start", err)
+	}
+}
+
+func TestConsensusPolicies(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"m", "a", "b", "c", "idle"} {
+		r.Join(id, id)
+		r.ChangeTeam(id, TeamRed)
+	}
+	r.Host = "m"
+	r.SwitchRole("m", PlayerRoleSpyMaster)
+	r.SetAway("idle", true)
+	r.startGame(seedStartedBy(TeamRed))
+	r.leaveLobby()
+	r.DeclareClue("m", "clue", ClueCountUnlimited)
+
+	if err := r.SwitchConsensus("m", "loudest"); err != ErrInvalidSetting {
+		t.Fatal("unknown consensus was accepted", err)
+	}
+
+	var tiles []Position
+	for i, row := range r.Game.Board {
+		for j, tile := range row {
+			if tile.Type == TileTypeRed {
+				tiles = append(tiles, Position{i, j})
+			}
+		}
+	}
+	flipped := func(pos Position) bool { return r.Game.Board[pos.I][pos.J].Flipped }
+
+	r.SwitchConsensus("m", ConsensusAll)
+	r.SelectTile("a", tiles[0].I, tiles[0].J)
+	r.SelectTile("a", tiles[0].I, tiles[0].J)
+	if r.Players["a"].GuessProposal == nil {
+		t.Fatal("clicking the proposed tile again withdrew the proposal")
+	}
+	r.WithdrawProposal("a")
+	if r.Players["a"].GuessProposal != nil {
+		t.Fatal("proposal was not withdrawn")
+	}
+
+	r.SwitchConsensus("m", ConsensusMajority)
+	r.SelectTile("a", tiles[0].I, tiles[0].J)
+	if flipped(tiles[0]) {
+		t.Fatal("one of three guessers flipped the tile")
+	}
+	r.SelectTile("b", tiles[0].I, tiles[0].J)
+	if !flipped(tiles[0]) {
+		t.Fatal("majority didn't flip the tile, the idle player held it up")
+	}
+
+	r.SwitchConsensus("m", ConsensusQuorum)
+	if err := r.ChangeConsensusSettings("m", ConsensusSettings{Quorum: 0, AutoCommitSeconds: 5}); err != ErrInvalidSetting {
+		t.Fatal("empty quorum was accepted", err)
+	}
+	r.ChangeConsensusSettings("m", ConsensusSettings{Quorum: 3, AutoCommitSeconds: 5})
+	r.SelectTile("a", tiles[1].I, tiles[1].J)
+	r.SelectTile("b", tiles[1].I, tiles[1].J)
+	if flipped(tiles[1]) {
+		t.Fatal("tile was flipped without a quorum")
+	}
+	r.SelectTile("c", tiles[1].I, tiles[1].J)
+	if !flipped(tiles[1]) {
+		t.Fatal("quorum didn't flip the tile")
+	}
+
+	r.SwitchConsensus("m", ConsensusAutoCommit)
+	r.SelectTile("a", tiles[2].I, tiles[2].J)
+	if _, ok := r.commitDue(); !ok || r.CommitProposal() {
+		t.Fatal("proposal wasn't waiting to be committed")
+	}
+	r.SelectTile("b", tiles[3].I, tiles[3].J)
+	if _, ok := r.commitDue(); ok {
+		t.Fatal("objection didn't stop the commit")
+	}
+	r.SelectTile("b", tiles[2].I, tiles[2].J)
+	for _, p := range r.Players {
+		p.proposedAt = p.proposedAt.Add(-time.Minute)
+	}
+	if !r.CommitProposal() || !flipped(tiles[2]) || r.Players["a"].GuessProposal != nil {
+		t.Fatal("proposal nobody objected to wasn't committed")
+	}
+}
+
+func TestTurnTimer(t *testing.T) {
+	interval := turnTimerInterval
+	turnTimerInterval = 20 * time.Millisecond
+	defer func() { turnTimerInterval = interval }()
+
+	a := NewActionRouter(RouterConfig{})
+	a.CreateRoom("p1", "", "player", "room", "pass", ResEmitFunc(func(string, bool) {}))
+	a.RoomForPlayer("p1", func(r *Room) { r.ForceStart("p1") })
+
+	timer := func() float64 {
+		left := make(chan float64)
+		a.RoomForPlayer("p1", func(r *Room) { left <- r.Game.Timer })
+		return <-left
+	}
+	switchMode := func(mode string) {
+		a.RoomForPlayer("p1", func(r *Room) { r.SwitchMode("p1", mode) })
+	}
+
+	start := timer()
+	// switching the mode again must not start a second clock
+	switchMode(ModeTimed)
+	switchMode(ModeTimed)
+	switchMode(ModeTimed)
+	time.Sleep(110 * time.Millisecond)
+	switchMode(ModeCasual)
+	ticked := start - timer()
+	if ticked < 3 || ticked > 6 {
+		t.Fatal("timer ran at the wrong speed", ticked)
+	}
+
+	paused := timer()
+	time.Sleep(60 * time.Millisecond)
+	if timer() != paused {
+		t.Fatal("timer kept running in casual mode")
+	}
+
+	switchMode(ModeTimed)
+	a.CloseRoom("room")
+	time.Sleep(60 * time.Millisecond)
+	if a.Rooms() != 0 {
+		t.Fatal("room with a running timer was not closed")
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("host", "host")
+	r.Join("red", "red")
+	r.Join("redmaster", "redmaster")
+	r.Join("blue", "blue")
+	r.Join("watcher", "watcher")
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.ChangeTeam("blue", TeamBlue)
+	r.ChangeTeam("host", TeamBlue)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.SwitchRole("watcher", PlayerRoleSpectator)
+	r.Game.Turn = TeamRed
+	r.leaveLobby()
+
+	if err := r.EndTurn("blue"); err != ErrNotYourTurn {
+		t.Fatal("other team ended the turn", err)
+	}
+	if err := r.EndTurn("watcher"); err != ErrNotYourTurn && err != ErrSpectator {
+		t.Fatal("spectator ended the turn", err)
+	}
+	if err := r.SelectTile("red", 0, 0); err != ErrNoClue {
+		t.Fatal("guess was allowed before the clue", err)
+	}
+	if err := r.DeclareClue("red", "clue", 1); err != ErrNotClueGiver {
+		t.Fatal("guesser gave a clue", err)
+	}
+	if err := r.EndTurn("nobody"); err != ErrNotInRoom {
+		t.Fatal("player outside the room ended the turn", err)
+	}
+	if err := r.SwitchRole("red", PlayerRoleSpyMaster); err != ErrTeamsLocked {
+		t.Fatal("guesser became spymaster during the game", err)
+	}
+	if err := r.ChangeTeam("blue", TeamRed); err != ErrTeamsLocked {
+		t.Fatal("player changed teams during the game", err)
+	}
+	if gs := r.GameStateFor("red"); gs.Game.Board[0][0].Type != "" {
+		t.Fatal("guesser sees the key", gs.Game.Board[0][0])
+	}
+
+	if err := r.EndTurn("red"); err != ErrNoClue || r.Game.Turn != TeamRed {
+		t.Fatal("guessers ended their turn before the clue", err)
+	}
+	if err := r.DeclareClue("redmaster", "clue", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.EndTurn("red"); err != nil {
+		t.Fatal("guesser could not end their turn", err)
+	}
+	if err := r.NewGame("blue"); err != ErrGameInProgress {
+		t.Fatal("non host restarted a running game", err)
+	}
+	if err := r.SwitchConsensus("blue", ConsensusAll); err != ErrGameInProgress {
+		t.Fatal("non host changed settings during the game", err)
+	}
+	if err := r.NewGame("host"); err != nil {
+		t.Fatal("host could not restart the game", err)
+	}
+	if err := r.SwitchRole("red", "king"); err != ErrInvalidSetting {
+		t.Fatal("player took a role that doesn't exist", err)
+	}
+	if err := r.ChangeTeam("red", "green"); err != ErrInvalidSetting {
+		t.Fatal("player joined a team that doesn't exist", err)
+	}
+	if err := r.SwitchRole("red", PlayerRoleSpyMaster); err != nil {
+		t.Fatal("role could not change before the game started", err)
+	}
+}
+
+func TestSpymasterLeavesGame(t *testing.T) {
+	r := NewRoom("room", "pass")
+	for _, id := range []string{"host", "red", "red2", "redmaster", "blue", "bluemaster"} {
+		r.Join(id, id)
+	}
+	r.ChangeTeam("host", TeamBlue)
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("red2", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.ChangeTeam("blue", TeamBlue)
+	r.ChangeTeam("bluemaster", TeamBlue)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+	r.SwitchRole("bluemaster", PlayerRoleSpyMaster)
+	r.Game.Turn = TeamRed
+	r.leaveLobby()
+
+	if err := r.SwitchRole("red", PlayerRoleSpyMaster); err != ErrTeamsLocked {
+		t.Fatal("guesser took the place of a spymaster who is still playing", err)
+	}
+	r.Leave("redmaster")
+	if err := r.SwitchRole("red", PlayerRoleSpyMaster); err != nil {
+		t.Fatal("team could not replace the spymaster who left", err)
+	}
+	if err := r.SwitchRole("red2", PlayerRoleSpyMaster); err != ErrTeamsLocked {
+		t.Fatal("team got a second spymaster during the game", err)
+	}
+	if err := r.DeclareClue("red", "clue", 1); err != nil {
+		t.Fatal("new spymaster could not give a clue", err)
+	}
+
+	r.Join("late", "late")
+	r.Players["late"].Team = TeamRed
+	if err := r.SwitchRole("late", PlayerRoleSpectator); err != nil {
+		t.Fatal("player could not become a spectator during the game", err)
+	}
+	if err := r.SwitchRole("late", PlayerRoleGuesser); err != ErrTeamsLocked {
+		t.Fatal("spectator started guessing during the game", err)
+	}
+
+	if err := r.AssignRole("blue", "late", PlayerRoleGuesser); err != ErrNotHost {
+		t.Fatal("non host assigned a role", err)
+	}
+	if err := r.AssignTeam("host", "late", TeamBlue); err != nil || r.Players["late"].Team != TeamBlue {
+		t.Fatal("host could not move a player during the game", err)
+	}
+	if err := r.AssignRole("host", "late", PlayerRoleGuesser); err != nil || r.Players["late"].Role != PlayerRoleGuesser {
+		t.Fatal("host could not give a player a role during the game", err)
+	}
+	if err := r.AssignRole("host", "nobody", PlayerRoleGuesser); err != ErrPlayerNotFound {
+		t.Fatal("role was given to a player outside the room", err)
+	}
+	if err := r.ChangeTeam("host", TeamRed); err != nil {
+		t.Fatal("host could not change their own team during the game", err)
+	}
+}
+
+func TestChatScopes(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("red", "red")
+	r.Join("blue", "blue")
+	r.Join("redmaster", "redmaster")
+	r.ChangeTeam("red", TeamRed)
+	r.ChangeTeam("redmaster", TeamRed)
+	r.ChangeTeam("blue", TeamBlue)
+	r.SwitchRole("redmaster", PlayerRoleSpyMaster)
+
+	if _, err := r.SendChat("red", ChatScopeSpyMasters, "hint"); err != ErrNotSpyMaster {
+		t.Fatal("guesser wrote to the spymaster channel", err)
+	}
+	if _, err := r.SendChat("red", ChatScopeAll, "   "); err != ErrEmptyChat {
+		t.Fatal("empty message was accepted", err)
+	}
+
+	r.SendChat("red", ChatScopeAll, "hello")
+	r.SendChat("red", ChatScopeTeam, "red only")
+	r.SendChat("redmaster", ChatScopeSpyMasters, "masters only")
+
+	if n := len(r.ChatHistoryFor("blue")); n != 1 {
+		t.Fatal("blue player should only see the public message", n)
+	}
+	if n := len(r.ChatHistoryFor("red")); n != 2 {
+		t.Fatal("red guesser should not see spymaster chat", n)
+	}
+	if n := len(r.ChatHistoryFor("redmaster")); n != 3 {
+		t.Fatal("red spymaster should see every message", n)
+	}
+}
+
+func TestClueValidation(t *testing.T) {
+	r := NewRoom("room", "pass")
+	r.Join("master", "master")
+	r.Join("guesser", "guesser")
+	r.ChangeTeam("master", r.Game.Turn)
+	r.ChangeTeam("guesser", r.Game.Turn)
+	r.SwitchRole("master", PlayerRoleSpyMaster)
+	r.leaveLobby()
+	for i := range r.Game.Board {
+		for j := range r.Game.Board[i] {
+			r.Game.Board[i][j].Word = fmt.Sprintf("WORD%d", i*5+j)
+		}
+	}
+	r.Game.Board[0][0].Word = "HORSE"
+	r.Game.Board[0][1].Word = "ICE CREAM"
+
+	if err := r.DeclareClue("guesser", "animal", 1); err != ErrNotClueGiver {
+		t.Fatal("guesser was allowed to give a clue", err)
+	}
+
+	rejected := []struct {
+		word  string
+		count int
+		rule  string
+	}{
+		{"two words", 1, "singleWord"},
+		{"horse", 1, "notBoardWord"},
+		{"horses", 1, "noSubstring"},
+		{"cream", 1, "noSubstring"},
+		{"animal", 10, "countInRange"},
+	}
+	for _, c := range rejected {
+		err := r.DeclareClue("master", c.word, c.count)
+		if ce, ok := err.(ClueError); !ok || ce.Rule != c.rule {
+			t.Fatal("expected clue to break rule", c.word, c.rule, err)
+		}
+	}
+
+	if err := r.SetClueRule("master", "singleWord", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.DeclareClue("master", "farm animal", ClueCountUnlimited); err != nil {
+		t.Fatal("clue should be accepted", err)
+	}
+	if err := r.DeclareClue("master", "another", 1); err != ErrClueGiven {
+		t.Fatal("second clue in a turn was accepted", err)
+	}
+	if r.Game.Clue.GuessesExhausted(20) {
+		t.Fatal("unlimited clue ran out of guesses")
+	}
+}
+
+func TestParseClueCount(t *testing.T) {
+	tests := []struct {
+		count string
+		want  int
+		valid bool
+	}{
+		{"3", 3, true},
+		{" 0 ", 0, true},
+		{"25", 25, true},
+		{"Unlimited", ClueCountUnlimited, true},
+		{"-1", 0, false},
+		{"-5", 0, false},
+		{"26", 0, false},
+		{"2147483647", 0, false},
+		{"99999999999999999999", 0, false},
+		{"two", 0, false},
+	}
+	for _, test := range tests {
+		n, err := ParseClueCount(test.count)
+		if (err == nil) != test.valid || n != test.want {
+			t.Error("unexpected count", test.count, n, err)
+		}
+	}
+
+	var c Clue
+	if err := json.Unmarshal([]byte(`{"word":"animal","count":-1}`), &c); err == nil {
+		t.Fatal("negative count was read as unlimited", c)
+	}
+}
+
+func TestClueJSON(t *testing.T) {
+	data, err := json.Marshal(Clue{Word: "animal", Count: ClueCountUnlimited})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"word":"animal","count":"unlimited"}` {
+		t.Fatal("unexpected encoding", string(data))
+	}
+
+	var c Clue
+	if err := json.Unmarshal(data, &c); err != nil || c.Count != ClueCountUnlimited {
+		t.Fatal("unlimited count did not round trip", c, err)
+	}
+}
+
+func TestWebSocketProtocol(t *testing.T) {
+	srv := httptest.NewServer(newWsServer(NewActionRouter(RouterConfig{})))
+	defer srv.Close()
+
+	dial := func(session string) *websocket.Conn {
+		url := "ws" + strings.TrimPrefix(srv.URL, "http") + "?sessionId=" + session
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+	// await reads until a message of the type and id shows up
+	await := func(conn *websocket.Conn, typ, id string) json.RawMessage {
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		for {
+			var env wsEnvelope
+			if err := conn.ReadJSON(&env); err != nil {
+				t.Fatal("no", typ, "received", err)
+			}
+			if env.Type == typ && env.ID == id {
+				return env.Payload
+			}
+		}
+	}
+	request := func(conn *websocket.Conn, typ, id string, payload interface{}) wsResponse {
+		data, _ := json.Marshal(payload)
+		conn.WriteJSON(wsEnvelope{Version: wsProtocolVersion, Type: typ, ID: id, Payload: data})
+		var res wsResponse
+		json.Unmarshal(await(conn, "response", id), &res)
+		return res
+	}
+
+	host := dial("host")
+	defer host.Close()
+	guest := dial("guest")
+	defer guest.Close()
+
+	res := request(host, "createRoom", "1", map[string]string{"room": "room", "nickname": "host", "password": "pass"})
+	if !res.Success {
+		t.Fatal("could not create room", res.Error)
+	}
+	res = request(guest, "joinRoom", "a", map[string]string{"room": "room", "nickname": "guest", "password": "pass"})
+	if !res.Success {
+		t.Fatal("could not join room", res.Error)
+	}
+
+	var gs gameState
+	json.Unmarshal(await(host, "gameState", ""), &gs)
+	for len(gs.Players) != 2 {
+		json.Unmarshal(await(host, "gameState", ""), &gs)
+	}
+
+	res = request(guest, "clickTile", "b", map[string]int{"i": 9, "j": 9})
+	if res.Success || res.Error == nil || res.Error.Action != "clickTile" {
+		t.Fatal("refused request was not reported", res)
+	}
+	res = request(guest, "flipTable", "c", nil)
+	if res.Error == nil || res.Error.Code != ErrUnknownRequest.Code {
+		t.Fatal("unknown request was accepted", res)
+	}
+}
+
+func TestRoomAPI(t *testing.T) {
+	a := NewActionRouter(RouterConfig{EmptyRoomGrace: time.Minute})
+	srv := httptest.NewServer(newAPIServer(a, "secret"))
+	defer srv.Close()
+
+	call := func(method, path, token string, body string, out interface{}) int {
+		req, _ := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		if out != nil {
+			json.NewDecoder(res.Body).Decode(out)
+		}
+		return res.StatusCode
+	}
+
+	if status := call("GET", "/api/rooms", "wrong", "", nil); status != http.StatusUnauthorized {
+		t.Fatal("api answered without the token", status)
+	}
+	if status := call("POST", "/api/rooms", "secret", `{"name": "a/b", "password": "hunter2"}`, nil); status != http.StatusCreated {
+		t.Fatal("could not create room", status)
+	}
+	if status := call("POST", "/api/rooms", "secret", `{"name": "a/b", "password": "hunter2"}`, nil); status != http.StatusConflict {
+		t.Fatal("room was created twice", status)
+	}
+
+	joined := make(chan *Room)
+	a.JoinRoom("player:api", "", "player", "a/b", "hunter2", func(r *Room) { joined <- r })
+	if <-joined == nil {
+		t.Fatal("could not join the room made over the api")
+	}
+
+	var rooms []apiRoomSummary
+	call("GET", "/api/rooms", "secret", "", &rooms)
+	if len(rooms) != 1 || rooms[0].Players != 1 {
+		t.Fatal("room list is wrong", rooms)
+	}
+
+	var state map[string]interface{}
+	call("GET", "/api/rooms/a%2Fb", "secret", "", &state)
+	data, _ := json.Marshal(state)
+	if strings.Contains(string(data), "player:api") || strings.Contains(string(data), "hunter2") {
+		t.Fatal("room state leaks player ids or the password", string(data))
+	}
+
+	var entries []GameLog
+	if status := call("GET", "/api/rooms/a%2Fb/log", "secret", "", &entries); status != http.StatusOK || entries == nil {
+		t.Fatal("could not download the log", status)
+	}
+
+	var records []*GameRecord
+	a.InspectRoom("a/b", func(r *Room) {
+		r.leaveLobby()
+		r.logEvent(nil, GameLog{Event: "endTurn", Team: r.Game.Turn})
+	})
+	call("GET", "/api/rooms/a%2Fb/history", "secret", "", &records)
+	if len(records) != 0 {
+		t.Fatal("history gives away the key of the game being played", len(records))
+	}
+	a.InspectRoom("a/b", func(r *Room) { r.Game.end(nil) })
+	call("GET", "/api/rooms/a%2Fb/history", "secret", "", &records)
+	if len(records) != 1 {
+		t.Fatal("finished game is missing from the history", len(records))
+	}
+
+	if status := call("DELETE", "/api/rooms/a%2Fb", "secret", "", nil); status != http.StatusNoContent {
+		t.Fatal("could not close the room", status)
+	}
+	if status := call("GET", "/api/rooms/a%2Fb", "secret", "", nil); status != http.StatusNotFound {
+		t.Fatal("closed room is still there", status)
+	}
+	if a.PlayerRoomReceiver("player:api") != nil {
+		t.Fatal("player is still mapped to the closed room")
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// maxBestOf keeps series to a length anyone would play
+const maxBestOf = 99
+
+var ErrBestOf = ActionError{Code: "invalidBestOf", Message: "a series has to be best of an odd number of games, or 0 to keep going"}
+
+// Series is the running score of the classic games played in a room
+type Series struct {
+	// BestOf ends the series once a team won more than half of the
+	// games, 0 keeps it going until it's reset
+	BestOf         int  `json:"bestOf"`
+	AlternateStart bool `json:"alternateStart"`
+
+	Games  int     `json:"games"`
+	Red    int     `json:"red"`
+	Blue   int     `json:"blue"`
+	Winner *string `json:"winner"`
+}
+
+// SeriesSettings are the series options players can change
+type SeriesSettings struct {
+	BestOf         int  `json:"bestOf"`
+	AlternateStart bool `json:"alternateStart"`
+}
+
+// ChangeSeriesSettings sets how consecutive games are played, changing
+// the length of the series starts a new one.
+func (r *Room) ChangeSeriesSettings(playerID string, settings SeriesSettings) error {
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+	if settings.BestOf < 0 || settings.BestOf > maxBestOf || (settings.BestOf > 0 && settings.BestOf%2 == 0) {
+		return ErrBestOf
+	}
+
+	if settings.BestOf != r.Series.BestOf {
+		r.resetSeries()
+	}
+	r.Series.BestOf = settings.BestOf
+	r.Series.AlternateStart = settings.AlternateStart
+	return nil
+}
+
+// ResetSeries sets the score back to 0
+func (r *Room) ResetSeries(playerID string) error {
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+	r.resetSeries()
+	return nil
+}
+
+func (r *Room) resetSeries() {
+	r.Series.Games = 0
+	r.Series.Red = 0
+	r.Series.Blue = 0
+	r.Series.Winner = nil
+}
+
+// countGame adds a finished classic game to the series score, or takes
+// it off again when the end of the game was taken back
+func (r *Room) countGame(winner *string, delta int) {
+	if r.Game.GameType != GameTypeClassic || winner == nil {
+		return
+	}
+
+	r.Series.Games += delta
+	switch *winner {
+	case TeamRed:
+		r.Series.Red += delta
+	case TeamBlue:
+		r.Series.Blue += delta
+	}
+
+	r.Series.Winner = nil
+	if r.Series.BestOf > 0 {
+		if r.Series.Red > r.Series.BestOf/2 {
+			r.Series.Winner = &TeamRed
+		} else if r.Series.Blue > r.Series.BestOf/2 {
+			r.Series.Winner = &TeamBlue
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"RoomName": r.Name,
+		"Red":      r.Series.Red,
+		"Blue":     r.Series.Blue,
+	}).Info("series score changed")
+}
+
+// startSeriesGame starts a new series once the last one was decided
+func (r *Room) startSeriesGame() {
+	if r.Series.Winner != nil {
+		r.resetSeries()
+	}
+}
+
+// gameSeed picks the seed of the room's next game. With alternating
+// starts the board is one the other team starts than the last game
+// played to its end, so the board code still deals the same board and
+// boards dealt again before anyone played don't switch the start.
+func (r *Room) gameSeed() int64 {
+	if !r.Series.AlternateStart {
+		return newSeed()
+	}
+	if start, ok := r.lastStart(); ok {
+		return seedStartedBy(otherTeam(start))
+	}
+	return newSeed()
+}
+
+// lastStart is the team that started the last game played to its end
+func (r *Room) lastStart() (string, bool) {
+	if r.Game != nil && r.Game.Over && r.Replay == nil && r.Game.record != nil {
+		return r.Game.record.Turn, true
+	}
+	for i := len(r.History) - 1; i >= 0; i-- {
+		if r.History[i].Over {
+			return r.History[i].Turn, true
+		}
+	}
+	return "", false
+}
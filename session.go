@@ -0,0 +1,73 @@
+package main
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"strings"
+)
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := crand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// newSessionToken makes the secret a client keeps to come back as
+// the same player, it is only ever sent to that client.
+func newSessionToken() string {
+	return randomHex(16)
+}
+
+// sessionPlayerID is the public id of the player holding the token,
+// it is shown to everyone in the room without giving the token away.
+func sessionPlayerID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "player:" + hex.EncodeToString(sum[:12])
+}
+
+// connectSession reuses the token the client sent or issues a new one
+func connectSession(token string) (string, string) {
+	if len(token) == 0 || token == "null" {
+		token = newSessionToken()
+	}
+	return token, sessionPlayerID(token)
+}
+
+const passwordHashPrefix = "sha256:"
+
+// hashPassword keeps room passwords out of the saved rooms, the salt
+// makes the same password look different in every room.
+func hashPassword(password string) string {
+	salt := randomHex(8)
+	return passwordHashPrefix + salt + ":" + saltedHash(salt, password)
+}
+
+func saltedHash(salt, password string) string {
+	sum := sha256.Sum256([]byte(salt + ":" + password))
+	return hex.EncodeToString(sum[:])
+}
+
+// checkPassword tells if the password matches the hash
+func checkPassword(hash, password string) bool {
+	parts := strings.SplitN(strings.TrimPrefix(hash, passwordHashPrefix), ":", 2)
+	if !strings.HasPrefix(hash, passwordHashPrefix) || len(parts) != 2 {
+		return false
+	}
+	want := saltedHash(parts[0], password)
+	return subtle.ConstantTimeCompare([]byte(want), []byte(parts[1])) == 1
+}
+
+// remoteHost strips the port from a connection's address, bans hold
+// for every connection from the host.
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
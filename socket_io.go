@@ -1,36 +1,92 @@
 package main
 
 import (
-	"math/rand"
 	"net/url"
 	"strconv"
-	"strings"
-	"time"
 
 	socketio "github.com/googollee/go-socket.io"
 	"github.com/sirupsen/logrus"
 )
 
-func socketServer(a *ActionRouter) *socketio.Server {
-	server := socketio.NewServer(nil)
+type connContext struct {
+	PlayerID string
+	Addr     string
+}
+
+type serverMessage struct {
+	Message string `json:"msg"`
+}
+
+// actionErrorMessage tells the player which request was refused and why
+type actionErrorMessage struct {
+	Action  string `json:"action"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func newActionErrorMessage(action string, err error) actionErrorMessage {
+	msg := actionErrorMessage{
+		Action:  action,
+		Code:    "error",
+		Message: err.Error(),
+	}
+	switch e := err.(type) {
+	case ActionError:
+		msg.Code = e.Code
+	case ClueError:
+		msg.Code = e.Rule
+	}
+	return msg
+}
+
+func emitActionError(s socketio.Conn, action string, err error) {
+	s.Emit("actionError", newActionErrorMessage(action, err))
+}
+
+type socketNotifier struct {
+	server *socketio.Server
+}
+
+func (n socketNotifier) RoomUpdated(r *Room) {
+	broadcastGameState(n.server, r)
+}
+
+func (n socketNotifier) RoomEvent(r *Room, event string, args ...interface{}) {
+	n.server.BroadcastToRoom("/", r.Name, event, args...)
+}
+
+func (n socketNotifier) PlayerEvent(r *Room, playerID, event string, args ...interface{}) {
+	for _, c := range playerConns(n.server, r, playerID) {
+		c.Emit(event, args...)
+	}
+}
 
-	type connContext struct {
-		PlayerID string
+func (n socketNotifier) PlayerRemoved(r *Room, playerID string) {
+	for _, c := range playerConns(n.server, r, playerID) {
+		c.Leave(r.Name)
+		c.Emit("reset")
 	}
+}
+
+func (n socketNotifier) ChatMessage(r *Room, msg ChatMessage) {
+	broadcastChat(n.server, r, msg)
+}
+
+func socketServer(a *ActionRouter) *socketio.Server {
+	server := socketio.NewServer(nil)
+	a.AddNotifier(socketNotifier{server})
 
 	server.OnConnect("/", func(s socketio.Conn) error {
-		playerID := randID("player")
+		var token string
 		vals, err := url.ParseQuery(s.URL().RawQuery)
 		if err == nil {
-			id := vals.Get("sessionId")
-			if len(id) > 0 && id != "null" {
-				log.WithField("ID", id).Info("Reusing id for new player")
-				playerID = id
-			}
+			token = vals.Get("sessionId")
 		}
+		token, playerID := connectSession(token)
 
 		ctx := connContext{
 			PlayerID: playerID,
+			Addr:     remoteHost(s.RemoteAddr().String()),
 		}
 
 		s.SetContext(ctx)
@@ -46,17 +102,25 @@ func socketServer(a *ActionRouter) *socketio.Server {
 				Players          int       `json:"players"`
 				Rooms            int       `json:"rooms"`
 				SessionID        string    `json:"sessionId"`
+				PlayerID         string    `json:"playerId"`
 				IsExistingPlayer bool      `json:"isExistingPlayer"`
 				GameState        gameState `json:"gameState,omitempty"`
 			}{
 				Players:          players,
 				Rooms:            rooms,
-				SessionID:        playerID,
+				SessionID:        token,
+				PlayerID:         playerID,
 				IsExistingPlayer: isInRoom,
 				GameState:        gs,
 			})
 		})
 
+		a.Reconnect(playerID, func(r *Room) {
+			s.Join(r.Name)
+			s.Emit("chatHistory", r.ChatHistoryFor(playerID))
+			a.notifier.RoomUpdated(r)
+		})
+
 		return nil
 	})
 
@@ -82,10 +146,9 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			"PlayerID":  ctx.PlayerID,
 			"Room":      req.Room,
 			"NickName":  req.Nickname,
-			"Password":  req.Password,
 		}).Info("create room request received")
 
-		a.CreateRoom(ctx.PlayerID, req.Nickname, req.Room, req.Password, ResEmitFunc(func(msg string, success bool) {
+		a.CreateRoom(ctx.PlayerID, ctx.Addr, req.Nickname, req.Room, req.Password, ResEmitFunc(func(msg string, success bool) {
 			if success {
 				s.Join(req.Room)
 			}
@@ -95,12 +158,100 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			})
 
 			a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-				server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+				a.notifier.RoomUpdated(r)
 			})
 
 		}))
 	})
 
+	type createReplayRoomRequest struct {
+		Room     string      `json:"room"`
+		Nickname string      `json:"nickname"`
+		Password string      `json:"password"`
+		Game     *GameRecord `json:"game"`
+	}
+	server.OnEvent("/", "createReplayRoom", func(s socketio.Conn, req createReplayRoomRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("context was not set")
+			return
+		}
+		log.WithFields(logrus.Fields{
+			"Operation": "createReplayRoom",
+			"PlayerID":  ctx.PlayerID,
+			"Room":      req.Room,
+			"NickName":  req.Nickname,
+		}).Info("create replay room request received")
+
+		a.CreateReplayRoom(ctx.PlayerID, ctx.Addr, req.Nickname, req.Room, req.Password, req.Game, ResEmitFunc(func(msg string, success bool) {
+			if success {
+				s.Join(req.Room)
+			}
+			s.Emit("createResponse", createRoomResponse{
+				Message: msg,
+				Success: success,
+			})
+
+			a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+				a.notifier.RoomUpdated(r)
+			})
+		}))
+	})
+
+	server.OnEvent("/", "exportGame", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in exportGame request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "exportGame",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received export game request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			rec, err := r.ExportGame(ctx.PlayerID)
+			if err != nil {
+				emitActionError(s, "exportGame", err)
+				return
+			}
+			s.Emit("gameExport", rec)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type replayStepRequest struct {
+		Step int `json:"step"`
+	}
+	server.OnEvent("/", "replayStep", func(s socketio.Conn, req replayStepRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in replayStep request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "replayStep",
+			"PlayerID":  ctx.PlayerID,
+			"Step":      req.Step,
+		}).Info("received replay step request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ReplayStep(ctx.PlayerID, req.Step); err != nil {
+				emitActionError(s, "replayStep", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
 	type joinRoomRequest struct {
 		Room     string `json:"room"`
 		Nickname string `json:"nickname"`
@@ -121,7 +272,6 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			"PlayerID":  ctx.PlayerID,
 			"Room":      req.Room,
 			"NickName":  req.Nickname,
-			"Password":  req.Password,
 		}).Info("join room request received")
 
 		if len(req.Nickname) == 0 {
@@ -138,7 +288,7 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			})
 		}
 
-		ok = a.JoinRoom(ctx.PlayerID, req.Nickname, req.Room, req.Password, func(r *Room) {
+		ok = a.JoinRoom(ctx.PlayerID, ctx.Addr, req.Nickname, req.Room, req.Password, func(r *Room) {
 			if r == nil {
 				s.Emit("joinResponse", joinRoomResponse{
 					Message: "cannot join room",
@@ -153,8 +303,9 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			})
 
 			s.Join(r.Name)
-			s.Emit("gameState", r.GameState())
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			s.Emit("gameState", r.GameStateFor(ctx.PlayerID))
+			s.Emit("chatHistory", r.ChatHistoryFor(ctx.PlayerID))
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			log.Warn("joining room failed")
@@ -182,7 +333,7 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		a.LeaveRoom(ctx.PlayerID, func(r *Room) {
 			s.Leave(r.Name)
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 
 		s.Emit("reset")
@@ -191,8 +342,10 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		})
 	})
 
+	// the host can move another player by naming them
 	type joinTeamRequest struct {
-		Team string
+		Team     string
+		PlayerID string `json:"playerId"`
 	}
 	server.OnEvent("/", "joinTeam", func(s socketio.Conn, req joinTeamRequest) {
 		ctx, ok := s.Context().(connContext)
@@ -208,10 +361,17 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("received join team request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.ChangeTeam(ctx.PlayerID, req.Team)
+			err := r.ChangeTeam(ctx.PlayerID, req.Team)
+			if req.PlayerID != "" && req.PlayerID != ctx.PlayerID {
+				err = r.AssignTeam(ctx.PlayerID, req.PlayerID, req.Team)
+			}
+			if err != nil {
+				emitActionError(s, "joinTeam", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
-			s.Emit("gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
+			s.Emit("gameState", r.GameStateFor(ctx.PlayerID))
 		})
 		if !ok {
 			s.Emit("reset")
@@ -231,9 +391,12 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("randomize teams request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.RandomizeTeams(ctx.PlayerID)
+			if err := r.RandomizeTeams(ctx.PlayerID); err != nil {
+				emitActionError(s, "randomizeTeams", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -247,16 +410,29 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			return
 		}
 
+		// a board code deals a board shared from another room
+		code, _ := vals["code"].(string)
+
 		log.WithFields(logrus.Fields{
 			"Operation": "newGame",
 			"PlayerID":  ctx.PlayerID,
+			"Code":      code,
 		}).Info("received new game request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.NewGame()
+			var err error
+			if len(code) > 0 {
+				err = r.NewGameFromCode(ctx.PlayerID, code)
+			} else {
+				err = r.NewGame(ctx.PlayerID)
+			}
+			if err != nil {
+				emitActionError(s, "newGame", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
-			s.Emit("gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
+			s.Emit("gameState", r.GameStateFor(ctx.PlayerID))
 		})
 		if !ok {
 			s.Emit("reset")
@@ -264,7 +440,8 @@ func socketServer(a *ActionRouter) *socketio.Server {
 	})
 
 	type switchRoleRequest struct {
-		Role string `json:"role"`
+		Role     string `json:"role"`
+		PlayerID string `json:"playerId"`
 	}
 	type switchRoleResponse struct {
 		Role    string `json:"role"`
@@ -284,14 +461,26 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("received switch role request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.SwitchRole(ctx.PlayerID, req.Role)
+			if req.PlayerID != "" && req.PlayerID != ctx.PlayerID {
+				if err := r.AssignRole(ctx.PlayerID, req.PlayerID, req.Role); err != nil {
+					emitActionError(s, "switchRole", err)
+					return
+				}
+				a.notifier.RoomUpdated(r)
+				return
+			}
+
+			if err := r.SwitchRole(ctx.PlayerID, req.Role); err != nil {
+				emitActionError(s, "switchRole", err)
+				return
+			}
 
 			s.Emit("switchRoleResponse", switchRoleResponse{
 				Role:    req.Role,
 				Success: true,
 			})
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -316,9 +505,12 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("received request to switch difficulty")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.ChangeDifficulty(ctx.PlayerID, req.Difficulty)
+			if err := r.ChangeDifficulty(ctx.PlayerID, req.Difficulty); err != nil {
+				emitActionError(s, "switchDifficulty", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -328,9 +520,6 @@ func socketServer(a *ActionRouter) *socketio.Server {
 	type switchModeRequest struct {
 		Mode string `json:"mode"`
 	}
-	type timerUpdateMessage struct {
-		Timer float64 `json:"timer"`
-	}
 	server.OnEvent("/", "switchMode", func(s socketio.Conn, req switchModeRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
@@ -345,24 +534,40 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("received request to switch mode")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.SwitchMode(ctx.PlayerID, req.Mode)
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			if err := r.SwitchMode(ctx.PlayerID, req.Mode); err != nil {
+				emitActionError(s, "switchMode", err)
+				return
+			}
+			a.notifier.RoomUpdated(r)
 		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type switchGameTypeRequest struct {
+		GameType string `json:"gameType"`
+	}
+	server.OnEvent("/", "switchGameType", func(s socketio.Conn, req switchGameTypeRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in switchGameType request")
+			return
+		}
 
-		a.TimedPlayerRoomAction(ctx.PlayerID, func(r *Room) bool {
-			state, turnOver := r.TimerTick()
-			if turnOver {
-				server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+		log.WithFields(logrus.Fields{
+			"Operation": "switchGameType",
+			"PlayerID":  ctx.PlayerID,
+			"GameType":  req.GameType,
+		}).Info("received request to switch game type")
 
-			}
-			if state == TickerStateContinue {
-				server.BroadcastToRoom("/", r.Name, "timerUpdate", timerUpdateMessage{
-					Timer: r.Game.Timer,
-				})
-				return true
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.SwitchGameType(ctx.PlayerID, req.GameType); err != nil {
+				emitActionError(s, "switchGameType", err)
+				return
 			}
 
-			return false
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -387,9 +592,64 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			"Consensus": req.Consensus,
 		}).Info("received request to switch consensus")
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.SwitchConsensus(ctx.PlayerID, req.Consensus)
+			if err := r.SwitchConsensus(ctx.PlayerID, req.Consensus); err != nil {
+				emitActionError(s, "switchConsensus", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "consensusSettings", func(s socketio.Conn, req ConsensusSettings) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in consensusSettings request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation":         "consensusSettings",
+			"PlayerID":          ctx.PlayerID,
+			"Quorum":            req.Quorum,
+			"AutoCommitSeconds": req.AutoCommitSeconds,
+		}).Info("received consensus settings request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ChangeConsensusSettings(ctx.PlayerID, req); err != nil {
+				emitActionError(s, "consensusSettings", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "withdrawProposal", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in withdrawProposal request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "withdrawProposal",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received withdraw proposal request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.WithdrawProposal(ctx.PlayerID); err != nil {
+				emitActionError(s, "withdrawProposal", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -409,9 +669,37 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}).Info("received request to end turn")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.EndTurn(ctx.PlayerID)
+			if err := r.EndTurn(ctx.PlayerID); err != nil {
+				emitActionError(s, "endTurn", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "undo", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in undo request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "undo",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received request to undo the last move")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.Undo(ctx.PlayerID); err != nil {
+				emitActionError(s, "undo", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -436,9 +724,12 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			"J":         req.J,
 		}).Info("received click tile request")
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.SelectTile(ctx.PlayerID, req.I, req.J)
+			if err := r.SelectTile(ctx.PlayerID, req.I, req.J); err != nil {
+				emitActionError(s, "clickTile", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
@@ -449,6 +740,24 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		Word  string `json:"word"`
 		Count string `json:"count"`
 	}
+	type declareClueResponse struct {
+		Success bool   `json:"success"`
+		Rule    string `json:"rule,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+	clueResponse := func(err error) declareClueResponse {
+		if err == nil {
+			return declareClueResponse{Success: true}
+		}
+		res := declareClueResponse{Message: err.Error()}
+		switch e := err.(type) {
+		case ClueError:
+			res.Rule = e.Rule
+		case ActionError:
+			res.Rule = e.Code
+		}
+		return res
+	}
 	server.OnEvent("/", "declareClue", func(s socketio.Conn, req declareClueRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
@@ -463,52 +772,61 @@ func socketServer(a *ActionRouter) *socketio.Server {
 			"Count":     req.Count,
 		}).Info("received declare clue request")
 
-		count, err := strconv.Atoi(req.Count)
+		count, err := ParseClueCount(req.Count)
 		if err != nil {
-			log.WithField("Count", req.Count).Warn("could not convert count to integer, using 1 as default")
-			count = 1
+			s.Emit("declareClueResponse", clueResponse(err))
+			return
 		}
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.DeclareClue(ctx.PlayerID, req.Word, count)
+			err := r.DeclareClue(ctx.PlayerID, req.Word, count)
+			s.Emit("declareClueResponse", clueResponse(err))
+			if err != nil {
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
 		}
 	})
 
-	type changeCardsRequest struct {
-		Pack string `json:"pack"`
+	type setClueRuleRequest struct {
+		Rule    string `json:"rule"`
+		Enabled bool   `json:"enabled"`
 	}
-	server.OnEvent("/", "changeCards", func(s socketio.Conn, req changeCardsRequest) {
+	server.OnEvent("/", "setClueRule", func(s socketio.Conn, req setClueRuleRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
-			log.Warn("connection context not set in joinTeam request")
+			log.Warn("connection context not set in setClueRule request")
 			return
 		}
 
 		log.WithFields(logrus.Fields{
-			"Operation": "changeCards",
+			"Operation": "setClueRule",
 			"PlayerID":  ctx.PlayerID,
-			"Pack":      req.Pack,
-		}).Info("received change cards pack request")
+			"Rule":      req.Rule,
+			"Enabled":   req.Enabled,
+		}).Info("received set clue rule request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.ChangeCards(ctx.PlayerID, req.Pack)
+			if err := r.SetClueRule(ctx.PlayerID, req.Rule, req.Enabled); err != nil {
+				emitActionError(s, "setClueRule", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
 		}
 	})
 
-	type timeSliderRequest struct {
-		Value string `json:"value"`
+	type changeCardsRequest struct {
+		Pack string `json:"pack"`
 	}
-	server.OnEvent("/", "timerSlider", func(s socketio.Conn, req timeSliderRequest) {
+	server.OnEvent("/", "changeCards", func(s socketio.Conn, req changeCardsRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
 			log.Warn("connection context not set in joinTeam request")
@@ -516,86 +834,583 @@ func socketServer(a *ActionRouter) *socketio.Server {
 		}
 
 		log.WithFields(logrus.Fields{
-			"Operation": "timeSlider",
+			"Operation": "changeCards",
 			"PlayerID":  ctx.PlayerID,
-			"Value":     req.Value,
-		}).Info("received update slider request")
-
-		val, err := strconv.ParseFloat(req.Value, 64)
-		if err != nil {
-			log.Warn("unable to parse time, using default")
-			val = 5
-		}
+			"Pack":      req.Pack,
+		}).Info("received change cards pack request")
 
 		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
-			r.ChangeTimer(ctx.PlayerID, val)
+			if err := r.ChangeCards(ctx.PlayerID, req.Pack); err != nil {
+				emitActionError(s, "changeCards", err)
+				return
+			}
 
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
 		}
 	})
 
-	server.OnError("/", func(s socketio.Conn, e error) {
-		if s == nil || s.Context() == nil {
-			return
-		}
-
+	type uploadWordPackRequest struct {
+		Name  string   `json:"name"`
+		Words []string `json:"words"`
+	}
+	server.OnEvent("/", "uploadWordPack", func(s socketio.Conn, req uploadWordPackRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
-			log.Warn("client context not found while handling error")
+			log.Warn("connection context not set in uploadWordPack request")
 			return
 		}
 
-		ok = a.LeaveRoom(ctx.PlayerID, func(r *Room) {
-			log.WithFields(logrus.Fields{
-				"PlayerID": ctx.PlayerID,
-				"RoomName": r.Name,
-			}).Warnf("error while handling socket.io request: %+v", e)
+		log.WithFields(logrus.Fields{
+			"Operation": "uploadWordPack",
+			"PlayerID":  ctx.PlayerID,
+			"Name":      req.Name,
+			"Words":     len(req.Words),
+		}).Info("received upload word pack request")
 
-			s.Leave(r.Name)
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if _, err := r.UploadWordPack(ctx.PlayerID, req.Name, req.Words); err != nil {
+				emitActionError(s, "uploadWordPack", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
 		})
 		if !ok {
 			s.Emit("reset")
 		}
-
 	})
-	server.OnDisconnect("/", func(s socketio.Conn, reason string) {
+
+	type setPackWeightRequest struct {
+		Pack    string `json:"pack"`
+		Percent int    `json:"percent"`
+	}
+	server.OnEvent("/", "setPackWeight", func(s socketio.Conn, req setPackWeightRequest) {
 		ctx, ok := s.Context().(connContext)
 		if !ok {
-			log.WithField("Reason", reason).Warn("received a disconnect event without client context")
+			log.Warn("connection context not set in setPackWeight request")
 			return
 		}
 
-		ok = a.LeaveRoom(ctx.PlayerID, func(r *Room) {
-			log.WithFields(logrus.Fields{
-				"PlayerID": ctx.PlayerID,
-				"RoomName": r.Name,
-				"Reason":   reason,
-			}).Info("closed connection")
+		log.WithFields(logrus.Fields{
+			"Operation": "setPackWeight",
+			"PlayerID":  ctx.PlayerID,
+			"Pack":      req.Pack,
+			"Percent":   req.Percent,
+		}).Info("received set pack weight request")
 
-			s.Leave(r.Name)
-			server.BroadcastToRoom("/", r.Name, "gameState", r.GameState())
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.SetPackWeight(ctx.PlayerID, req.Pack, req.Percent); err != nil {
+				emitActionError(s, "setPackWeight", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type timeSliderRequest struct {
+		Value string `json:"value"`
+	}
+	server.OnEvent("/", "timerSlider", func(s socketio.Conn, req timeSliderRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in joinTeam request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "timeSlider",
+			"PlayerID":  ctx.PlayerID,
+			"Value":     req.Value,
+		}).Info("received update slider request")
+
+		val, err := strconv.ParseFloat(req.Value, 64)
+		if err != nil {
+			log.Warn("unable to parse time, using default")
+			val = 5
+		}
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ChangeTimer(ctx.PlayerID, val); err != nil {
+				emitActionError(s, "timerSlider", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type moderationRequest struct {
+		PlayerID string `json:"playerId"`
+	}
+	moderationResponse := func(s socketio.Conn, action string) func(r *Room, err error) {
+		return func(r *Room, err error) {
+			if err != nil {
+				emitActionError(s, action, err)
+				return
+			}
+			a.notifier.RoomUpdated(r)
+		}
+	}
+
+	server.OnEvent("/", "kickPlayer", func(s socketio.Conn, req moderationRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in kickPlayer request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "kickPlayer",
+			"PlayerID":  ctx.PlayerID,
+			"TargetID":  req.PlayerID,
+		}).Info("received kick player request")
+
+		if !a.Kick(ctx.PlayerID, req.PlayerID, false, moderationResponse(s, "kickPlayer")) {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "banPlayer", func(s socketio.Conn, req moderationRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in banPlayer request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "banPlayer",
+			"PlayerID":  ctx.PlayerID,
+			"TargetID":  req.PlayerID,
+		}).Info("received ban player request")
+
+		if !a.Kick(ctx.PlayerID, req.PlayerID, true, moderationResponse(s, "banPlayer")) {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "transferHost", func(s socketio.Conn, req moderationRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in transferHost request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "transferHost",
+			"PlayerID":  ctx.PlayerID,
+			"TargetID":  req.PlayerID,
+		}).Info("received transfer host request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			moderationResponse(s, "transferHost")(r, r.TransferHost(ctx.PlayerID, req.PlayerID))
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type lockSettingsRequest struct {
+		Locked bool `json:"locked"`
+	}
+	server.OnEvent("/", "lockSettings", func(s socketio.Conn, req lockSettingsRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in lockSettings request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "lockSettings",
+			"PlayerID":  ctx.PlayerID,
+			"Locked":    req.Locked,
+		}).Info("received lock settings request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			moderationResponse(s, "lockSettings")(r, r.LockSettings(ctx.PlayerID, req.Locked))
 		})
 		if !ok {
 			s.Emit("reset")
 		}
+	})
+
+	type chatRequest struct {
+		Scope string `json:"scope"`
+		Text  string `json:"text"`
+	}
+	server.OnEvent("/", "chat", func(s socketio.Conn, req chatRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in chat request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "chat",
+			"PlayerID":  ctx.PlayerID,
+			"Scope":     req.Scope,
+		}).Info("received chat message")
 
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			msg, err := r.SendChat(ctx.PlayerID, req.Scope, req.Text)
+			if err != nil {
+				emitActionError(s, "chat", err)
+				return
+			}
+			a.notifier.ChatMessage(r, msg)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "seriesSettings", func(s socketio.Conn, req SeriesSettings) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in seriesSettings request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation":      "seriesSettings",
+			"PlayerID":       ctx.PlayerID,
+			"BestOf":         req.BestOf,
+			"AlternateStart": req.AlternateStart,
+		}).Info("received series settings request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ChangeSeriesSettings(ctx.PlayerID, req); err != nil {
+				emitActionError(s, "seriesSettings", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "timerSettings", func(s socketio.Conn, req TimerSettings) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in timerSettings request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation":    "timerSettings",
+			"PlayerID":     ctx.PlayerID,
+			"ClueSeconds":  req.ClueSeconds,
+			"GuessSeconds": req.GuessSeconds,
+			"BankSeconds":  req.BankSeconds,
+		}).Info("received timer settings request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ChangeTimerSettings(ctx.PlayerID, req); err != nil {
+				emitActionError(s, "timerSettings", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type readyRequest struct {
+		Ready bool `json:"ready"`
+	}
+	server.OnEvent("/", "ready", func(s socketio.Conn, req readyRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in ready request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "ready",
+			"PlayerID":  ctx.PlayerID,
+			"Ready":     req.Ready,
+		}).Info("received ready request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.SetReady(ctx.PlayerID, req.Ready); err != nil {
+				emitActionError(s, "ready", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "startGame", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in startGame request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "startGame",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received start game request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ForceStart(ctx.PlayerID); err != nil {
+				emitActionError(s, "startGame", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type rolePolicyRequest struct {
+		Policy string `json:"policy"`
+	}
+	server.OnEvent("/", "rolePolicy", func(s socketio.Conn, req rolePolicyRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in rolePolicy request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "rolePolicy",
+			"PlayerID":  ctx.PlayerID,
+			"Policy":    req.Policy,
+		}).Info("received role policy request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ChangeRolePolicy(ctx.PlayerID, req.Policy); err != nil {
+				emitActionError(s, "rolePolicy", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "resetSeries", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in resetSeries request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "resetSeries",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received reset series request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ResetSeries(ctx.PlayerID); err != nil {
+				emitActionError(s, "resetSeries", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "pauseGame", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in pauseGame request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "pauseGame",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received pause game request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.PauseGame(ctx.PlayerID); err != nil {
+				emitActionError(s, "pauseGame", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "resumeGame", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in resumeGame request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "resumeGame",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("received resume game request")
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			if err := r.ResumeGame(ctx.PlayerID); err != nil {
+				emitActionError(s, "resumeGame", err)
+				return
+			}
+
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	type leaderboardRequest struct {
+		Scope string `json:"scope"`
+	}
+	server.OnEvent("/", "leaderboard", func(s socketio.Conn, req leaderboardRequest) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in leaderboard request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "leaderboard",
+			"PlayerID":  ctx.PlayerID,
+			"Scope":     req.Scope,
+		}).Info("received leaderboard request")
+
+		if _, ok := StatsScopes[req.Scope]; !ok {
+			emitActionError(s, "leaderboard", ErrInvalidSetting)
+			return
+		}
+		if req.Scope == StatsScopeServer {
+			s.Emit("leaderboard", a.stats.Leaderboard(""))
+			return
+		}
+
+		ok = a.RoomForPlayer(ctx.PlayerID, func(r *Room) {
+			s.Emit("leaderboard", a.stats.Leaderboard(r.Name))
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+	})
+
+	server.OnEvent("/", "active", func(s socketio.Conn) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("connection context not set in active request")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"Operation": "active",
+			"PlayerID":  ctx.PlayerID,
+		}).Info("player is not afk")
+
+		// routing any action through the room resets the afk timer
+		a.RoomForPlayer(ctx.PlayerID, func(r *Room) {})
+	})
+
+	server.OnError("/", func(s socketio.Conn, e error) {
+		if s == nil || s.Context() == nil {
+			return
+		}
+
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.Warn("client context not found while handling error")
+			return
+		}
+
+		ok = a.LeaveRoom(ctx.PlayerID, func(r *Room) {
+			log.WithFields(logrus.Fields{
+				"PlayerID": ctx.PlayerID,
+				"RoomName": r.Name,
+			}).Warnf("error while handling socket.io request: %+v", e)
+
+			s.Leave(r.Name)
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			s.Emit("reset")
+		}
+
+	})
+	server.OnDisconnect("/", func(s socketio.Conn, reason string) {
+		ctx, ok := s.Context().(connContext)
+		if !ok {
+			log.WithField("Reason", reason).Warn("received a disconnect event without client context")
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"PlayerID": ctx.PlayerID,
+			"Reason":   reason,
+		}).Info("closed connection")
+
+		a.Disconnect(ctx.PlayerID)
 	})
 	return server
 }
 
-func randID(typ string) string {
-	rand.Seed(time.Now().UnixNano())
-	chars := []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZÅÄÖ" + "abcdefghijklmnopqrstuvwxyz" + "0123456789")
-	length := 16
-	var b strings.Builder
-	b.WriteString(typ)
-	b.WriteByte(':')
-	for i := 0; i < length; i++ {
-		b.WriteRune(chars[rand.Intn(len(chars))])
-	}
-	return b.String()
+// broadcastGameState sends every connection in the room its own view
+// of the game state.
+func broadcastGameState(server *socketio.Server, r *Room) {
+	server.ForEach("/", r.Name, func(c socketio.Conn) {
+		ctx, ok := c.Context().(connContext)
+		if !ok {
+			return
+		}
+		c.Emit("gameState", r.GameStateFor(ctx.PlayerID))
+	})
+}
+
+// broadcastChat sends the message to everyone in the room allowed to read it
+func broadcastChat(server *socketio.Server, r *Room, msg ChatMessage) {
+	server.ForEach("/", r.Name, func(c socketio.Conn) {
+		ctx, ok := c.Context().(connContext)
+		if !ok {
+			return
+		}
+		if p, ok := r.Player(ctx.PlayerID); ok && msg.VisibleTo(p) {
+			c.Emit("chatMessage", msg)
+		}
+	})
+}
+
+// playerConns finds the connections of a player in the room, they are
+// collected first so callers can change room membership.
+func playerConns(server *socketio.Server, r *Room, playerID string) []socketio.Conn {
+	conns := []socketio.Conn{}
+	server.ForEach("/", r.Name, func(c socketio.Conn) {
+		ctx, ok := c.Context().(connContext)
+		if ok && ctx.PlayerID == playerID {
+			conns = append(conns, c)
+		}
+	})
+	return conns
 }
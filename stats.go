@@ -0,0 +1,370 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxStatsResults is how many finished games the stats are kept for
+const maxStatsResults = 10000
+
+var (
+	StatsScopeRoom   = "room"
+	StatsScopeServer = "server"
+	StatsScopes      = buildSet(StatsScopeRoom, StatsScopeServer)
+)
+
+// GameResult is what a finished game adds to the stats
+type GameResult struct {
+	GameID   string         `json:"gameId"`
+	Room     string         `json:"room"`
+	GameType string         `json:"gameType"`
+	Winner   string         `json:"winner"`
+	Players  []PlayerResult `json:"players"`
+}
+
+// PlayerResult is how a player did in one game, the role is the one
+// they had when the game ended
+type PlayerResult struct {
+	Nickname      string `json:"nickname"`
+	Team          string `json:"team"`
+	Role          string `json:"role"`
+	Won           bool   `json:"won"`
+	AssassinHits  int    `json:"assassinHits"`
+	Clues         int    `json:"clues"`
+	NumberedClues int    `json:"numberedClues"`
+	ClueTotal     int    `json:"clueTotal"`
+	Guesses       int    `json:"guesses"`
+	Correct       int    `json:"correct"`
+}
+
+// PlayerStats sums up the results of a nickname
+type PlayerStats struct {
+	Nickname        string  `json:"nickname"`
+	Games           int     `json:"games"`
+	Wins            int     `json:"wins"`
+	Losses          int     `json:"losses"`
+	SpymasterWins   int     `json:"spymasterWins"`
+	SpymasterLosses int     `json:"spymasterLosses"`
+	GuesserWins     int     `json:"guesserWins"`
+	GuesserLosses   int     `json:"guesserLosses"`
+	AssassinHits    int     `json:"assassinHits"`
+	Clues           int     `json:"clues"`
+	AverageClue     float64 `json:"averageClue"`
+	Guesses         int     `json:"guesses"`
+	Accuracy        float64 `json:"accuracy"`
+
+	numberedClues int
+	clueTotal     int
+	correct       int
+}
+
+// Leaderboard is the stats of a room, or of the whole server when
+// the room is empty
+type Leaderboard struct {
+	Room     string         `json:"room,omitempty"`
+	Games    int            `json:"games"`
+	TeamWins map[string]int `json:"teamWins"`
+	Players  []PlayerStats  `json:"players"`
+}
+
+// StatsStore keeps the results of finished games
+type StatsStore interface {
+	Save(results []GameResult) error
+	Load() ([]GameResult, error)
+}
+
+type nopStatsStore struct{}
+
+func (nopStatsStore) Save(results []GameResult) error { return nil }
+func (nopStatsStore) Load() ([]GameResult, error)     { return nil, nil }
+
+// FileStatsStore keeps the results in one json file
+type FileStatsStore struct {
+	path string
+}
+
+func NewFileStatsStore(path string) (*FileStatsStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	return &FileStatsStore{path: path}, nil
+}
+
+func (f *FileStatsStore) Save(results []GameResult) error {
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	tmp := f.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path)
+}
+
+func (f *FileStatsStore) Load() ([]GameResult, error) {
+	data, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := []GameResult{}
+	err = json.Unmarshal(data, &results)
+	return results, err
+}
+
+// Stats collects the results of every room's games
+type Stats struct {
+	sync.Mutex
+	store   StatsStore
+	results []GameResult
+}
+
+func NewStats(store StatsStore) *Stats {
+	results, err := store.Load()
+	if err != nil {
+		log.WithField("Error", err).Warn("unable to load stats")
+	}
+	return &Stats{
+		store:   store,
+		results: results,
+	}
+}
+
+// Record adds the result of a game, a game that ends again after a
+// move was taken back replaces its earlier result.
+func (s *Stats) Record(res GameResult) {
+	s.Lock()
+	defer s.Unlock()
+
+	replaced := false
+	for i := range s.results {
+		if s.results[i].GameID == res.GameID {
+			s.results[i] = res
+			replaced = true
+		}
+	}
+	if !replaced {
+		s.results = append(s.results, res)
+	}
+	if len(s.results) > maxStatsResults {
+		s.results = s.results[len(s.results)-maxStatsResults:]
+	}
+
+	if err := s.store.Save(s.results); err != nil {
+		log.WithField("Error", err).Warn("unable to save stats")
+	}
+	log.WithFields(logrus.Fields{
+		"RoomName": res.Room,
+		"Winner":   res.Winner,
+	}).Info("recorded game result")
+}
+
+// Forget drops the result of a game whose last move was taken back
+func (s *Stats) Forget(gameID string) {
+	s.Lock()
+	defer s.Unlock()
+
+	results := s.results[:0]
+	for _, res := range s.results {
+		if res.GameID != gameID {
+			results = append(results, res)
+		}
+	}
+	if len(results) == len(s.results) {
+		return
+	}
+	s.results = results
+
+	if err := s.store.Save(s.results); err != nil {
+		log.WithField("Error", err).Warn("unable to save stats")
+	}
+	log.WithField("GameID", gameID).Info("forgot game result")
+}
+
+// Leaderboard sums up the results of the room, or of every room when
+// room is empty. Players are ranked by wins, then by fewer games.
+func (s *Stats) Leaderboard(room string) Leaderboard {
+	s.Lock()
+	defer s.Unlock()
+
+	board := Leaderboard{
+		Room:     room,
+		TeamWins: map[string]int{},
+		Players:  []PlayerStats{},
+	}
+	players := map[string]*PlayerStats{}
+	for _, res := range s.results {
+		if len(room) > 0 && res.Room != room {
+			continue
+		}
+		board.Games++
+		if len(res.Winner) > 0 {
+			board.TeamWins[res.Winner]++
+		}
+
+		for _, pr := range res.Players {
+			ps, ok := players[pr.Nickname]
+			if !ok {
+				ps = &PlayerStats{Nickname: pr.Nickname}
+				players[pr.Nickname] = ps
+			}
+			ps.add(pr)
+		}
+	}
+
+	for _, ps := range players {
+		if ps.numberedClues > 0 {
+			ps.AverageClue = float64(ps.clueTotal) / float64(ps.numberedClues)
+		}
+		if ps.Guesses > 0 {
+			ps.Accuracy = float64(ps.correct) / float64(ps.Guesses)
+		}
+		board.Players = append(board.Players, *ps)
+	}
+	sort.Slice(board.Players, func(i, j int) bool {
+		a, b := board.Players[i], board.Players[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Games != b.Games {
+			return a.Games < b.Games
+		}
+		return a.Nickname < b.Nickname
+	})
+	return board
+}
+
+func (ps *PlayerStats) add(pr PlayerResult) {
+	ps.Games++
+	spymaster := pr.Role == PlayerRoleSpyMaster
+	switch {
+	case pr.Won && spymaster:
+		ps.Wins++
+		ps.SpymasterWins++
+	case pr.Won:
+		ps.Wins++
+		ps.GuesserWins++
+	case spymaster:
+		ps.Losses++
+		ps.SpymasterLosses++
+	default:
+		ps.Losses++
+		ps.GuesserLosses++
+	}
+	ps.AssassinHits += pr.AssassinHits
+	ps.Clues += pr.Clues
+	ps.numberedClues += pr.NumberedClues
+	ps.clueTotal += pr.ClueTotal
+	ps.Guesses += pr.Guesses
+	ps.correct += pr.Correct
+}
+
+// checkGameOver counts the game for the series and queues its result
+// when the move ended it, the router hands queued results to the
+// stats after each action.
+func (r *Room) checkGameOver(wasOver bool) {
+	if wasOver || !r.Game.Over || r.Replay != nil {
+		return
+	}
+	r.countGame(r.Game.Winner, 1)
+	r.results = append(r.results, r.gameResult())
+}
+
+// uncountGame takes the game out of the series and the stats when the
+// move that ended it was taken back
+func (r *Room) uncountGame() {
+	r.countGame(r.Game.Winner, -1)
+
+	id := r.gameID()
+	results := r.results[:0]
+	for _, res := range r.results {
+		if res.GameID != id {
+			results = append(results, res)
+		}
+	}
+	r.results = results
+	r.undone = append(r.undone, id)
+}
+
+// takeResults returns the queued results and the games whose results
+// were taken back, and clears both queues
+func (r *Room) takeResults() ([]GameResult, []string) {
+	results, undone := r.results, r.undone
+	r.results = nil
+	r.undone = nil
+	return results, undone
+}
+
+func (r *Room) gameID() string {
+	rec := r.Game.Record()
+	return fmt.Sprintf("%s/%s/%d", r.Name, rec.BoardCode, rec.StartedAt.UnixNano())
+}
+
+func (r *Room) gameResult() GameResult {
+	res := GameResult{
+		GameID:   r.gameID(),
+		Room:     r.Name,
+		GameType: r.Game.GameType,
+		Players:  []PlayerResult{},
+	}
+	if r.Game.Winner != nil {
+		res.Winner = *r.Game.Winner
+	}
+
+	results := map[string]*PlayerResult{}
+	for _, p := range r.Players {
+		if p.Role == PlayerRoleSpectator {
+			continue
+		}
+		results[p.NickName] = &PlayerResult{
+			Nickname: p.NickName,
+			Team:     p.Team,
+			Role:     p.Role,
+			Won:      res.Winner == p.Team || res.Winner == DuetWinner,
+		}
+	}
+
+	for _, e := range r.Game.Log {
+		pr, ok := results[e.Player]
+		if !ok || e.Undone {
+			continue
+		}
+		switch e.Event {
+		case "declareClue":
+			pr.Clues++
+			if e.Clue.Count != ClueCountUnlimited {
+				pr.NumberedClues++
+				pr.ClueTotal += e.Clue.Count
+			}
+		case "flipTile":
+			pr.Guesses++
+			if e.Type == e.Team || e.Type == TileTypeGreen {
+				pr.Correct++
+			}
+			if e.Type == TileTypeBlack {
+				pr.AssassinHits++
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res.Players = append(res.Players, *results[name])
+	}
+	return res
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RoomStore persists rooms so games survive a server restart
+type RoomStore interface {
+	Save(r *Room) error
+	Delete(roomName string) error
+	Load() ([]*Room, error)
+}
+
+type nopRoomStore struct{}
+
+func (nopRoomStore) Save(r *Room) error           { return nil }
+func (nopRoomStore) Delete(roomName string) error { return nil }
+func (nopRoomStore) Load() ([]*Room, error)       { return nil, nil }
+
+// roomSnapshot carries the unexported room and game state
+// that plain json encoding of a Room would drop. The history is
+// left out, it only changes when a game ends and is saved on its own.
+type roomSnapshot struct {
+	Room
+	History     []*GameRecord   `json:"history,omitempty"`
+	Game        *gameSnapshot   `json:"game"`
+	TimerAmount float64         `json:"timerAmount"`
+	Undo        []*gameSnapshot `json:"undo,omitempty"`
+
+	// rooms saved before passwords were hashed
+	PlainPassword string `json:"password,omitempty"`
+
+	// when each pending proposal was made, auto-commit counts from it
+	ProposedAt map[string]time.Time `json:"proposedAt,omitempty"`
+	UndoVotes  []string             `json:"undoVotes,omitempty"`
+	PauseVotes []string             `json:"pauseVotes,omitempty"`
+
+	// where each player connects from, a ban covers the address too
+	Addrs map[string]string `json:"addrs,omitempty"`
+}
+
+type gameSnapshot struct {
+	Game
+	TurnsTaken int                 `json:"turnsTaken"`
+	KeyCards   map[string][]string `json:"keyCards,omitempty"`
+	Seed       int64               `json:"seed"`
+	Record     *GameRecord         `json:"record,omitempty"`
+}
+
+func newRoomSnapshot(r *Room) roomSnapshot {
+	snap := roomSnapshot{
+		Room:        *r,
+		TimerAmount: r.timerAmount,
+	}
+	if r.Game != nil {
+		snap.Game = newGameSnapshot(r.Game)
+	}
+	for _, g := range r.undo {
+		snap.Undo = append(snap.Undo, newGameSnapshot(g))
+	}
+	for id, p := range r.Players {
+		if p.addr != "" {
+			if snap.Addrs == nil {
+				snap.Addrs = map[string]string{}
+			}
+			snap.Addrs[id] = p.addr
+		}
+		if p.GuessProposal == nil {
+			continue
+		}
+		if snap.ProposedAt == nil {
+			snap.ProposedAt = map[string]time.Time{}
+		}
+		snap.ProposedAt[id] = p.proposedAt
+	}
+	snap.UndoVotes = voteList(r.undoVotes)
+	snap.PauseVotes = voteList(r.pauseVotes)
+	return snap
+}
+
+func voteList(votes map[string]struct{}) []string {
+	var ids []string
+	for id := range votes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func voteSet(ids []string) map[string]struct{} {
+	if len(ids) == 0 {
+		return nil
+	}
+	return buildSet(ids...)
+}
+
+func newGameSnapshot(g *Game) *gameSnapshot {
+	return &gameSnapshot{
+		Game:       *g,
+		TurnsTaken: g.turnsTaken,
+		KeyCards:   g.keyCards,
+		Seed:       g.seed,
+		Record:     g.record,
+	}
+}
+
+func (s *gameSnapshot) restore() *Game {
+	g := s.Game
+	g.turnsTaken = s.TurnsTaken
+	g.keyCards = s.KeyCards
+	g.seed = s.Seed
+	g.record = s.Record
+	if g.Phase == "" {
+		g.Phase = gamePhase(&g)
+	}
+	return &g
+}
+
+func (s roomSnapshot) restore() *Room {
+	r := s.Room
+	// snapshots saved before the history had its own file carry it
+	r.History = s.History
+	r.timerAmount = s.TimerAmount
+	r.Game = nil
+	if s.Game != nil {
+		r.Game = s.Game.restore()
+	}
+	r.undo = nil
+	for _, g := range s.Undo {
+		r.undo = append(r.undo, g.restore())
+	}
+	if r.Players == nil {
+		r.Players = map[string]*Player{}
+	}
+	for id, at := range s.ProposedAt {
+		if p, ok := r.Players[id]; ok {
+			p.proposedAt = at
+		}
+	}
+	for id, addr := range s.Addrs {
+		if p, ok := r.Players[id]; ok {
+			p.addr = addr
+		}
+	}
+	r.undoVotes = voteSet(s.UndoVotes)
+	r.pauseVotes = voteSet(s.PauseVotes)
+	if s.PlainPassword != "" {
+		r.Password = hashPassword(s.PlainPassword)
+	}
+	if r.RolePolicy == "" {
+		r.RolePolicy = RolePolicyManual
+	}
+	if r.ConsensusSettings.Quorum == 0 {
+		r.ConsensusSettings = defaultConsensusSettings()
+	}
+	return &r
+}
+
+// FileRoomStore keeps one json file per room in a directory, and
+// the room's history in a second file next to it
+type FileRoomStore struct {
+	sync.Mutex
+	dir  string
+	last map[string][]byte
+	// the newest game of each room's history that was written
+	history map[string]*GameRecord
+}
+
+func NewFileRoomStore(dir string) (*FileRoomStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileRoomStore{
+		dir:     dir,
+		last:    map[string][]byte{},
+		history: map[string]*GameRecord{},
+	}, nil
+}
+
+func (f *FileRoomStore) path(roomName string) string {
+	return filepath.Join(f.dir, url.PathEscape(roomName)+".json")
+}
+
+func (f *FileRoomStore) historyPath(roomName string) string {
+	return filepath.Join(f.dir, url.PathEscape(roomName)+historySuffix)
+}
+
+const historySuffix = ".history"
+
+// writeFile replaces the file so a crash never leaves half of it
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// lastGame is the newest game of the history, games are never changed
+// once they're in it so it tells if the history has to be written
+func lastGame(history []*GameRecord) *GameRecord {
+	if len(history) == 0 {
+		return nil
+	}
+	return history[len(history)-1]
+}
+
+func (f *FileRoomStore) Save(r *Room) error {
+	data, err := json.Marshal(newRoomSnapshot(r))
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if last := lastGame(r.History); last != f.history[r.Name] {
+		history, err := json.Marshal(r.History)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(f.historyPath(r.Name), history); err != nil {
+			return err
+		}
+		f.history[r.Name] = last
+	}
+
+	// most actions don't change anything worth writing
+	if bytes.Equal(f.last[r.Name], data) {
+		return nil
+	}
+	if err := writeFile(f.path(r.Name), data); err != nil {
+		return err
+	}
+	f.last[r.Name] = data
+	return nil
+}
+
+func (f *FileRoomStore) Delete(roomName string) error {
+	f.Lock()
+	defer f.Unlock()
+
+	delete(f.last, roomName)
+	delete(f.history, roomName)
+	if err := os.Remove(f.historyPath(roomName)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	err := os.Remove(f.path(roomName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (f *FileRoomStore) Load() ([]*Room, error) {
+	files, err := ioutil.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rooms := []*Room{}
+	for _, fi := range files {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(f.dir, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var snap roomSnapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			log.WithFields(logrus.Fields{
+				"File":  fi.Name(),
+				"Error": err,
+			}).Warn("skipping unreadable room snapshot")
+			continue
+		}
+
+		r := snap.restore()
+		if data, err := ioutil.ReadFile(f.historyPath(r.Name)); err == nil {
+			var history []*GameRecord
+			if err := json.Unmarshal(data, &history); err != nil {
+				log.WithFields(logrus.Fields{
+					"RoomName": r.Name,
+					"Error":    err,
+				}).Warn("skipping unreadable room history")
+			} else {
+				r.History = history
+			}
+		}
+		f.last[r.Name] = data
+		f.history[r.Name] = lastGame(r.History)
+		rooms = append(rooms, r)
+	}
+	return rooms, nil
+}
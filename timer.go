@@ -0,0 +1,253 @@
+package main
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// turnTimerInterval is how long one second of a turn timer takes
+var turnTimerInterval = time.Second
+
+// turnTimer is the clock of a room, it's owned by the room's router
+// goroutine. Pausing it keeps what's left of the current second so
+// pausing and resuming doesn't speed up or slow down the turn.
+type turnTimer struct {
+	timer *time.Timer
+	due   time.Time
+	left  time.Duration
+}
+
+func newTurnTimer() *turnTimer {
+	return &turnTimer{left: turnTimerInterval}
+}
+
+// C fires when the next second is over, it's nil while the timer is
+// paused so selecting on it blocks.
+func (t *turnTimer) C() <-chan time.Time {
+	if t.timer == nil {
+		return nil
+	}
+	return t.timer.C
+}
+
+// set resumes or pauses the timer
+func (t *turnTimer) set(running bool) {
+	if running {
+		t.resume()
+	} else {
+		t.pause()
+	}
+}
+
+func (t *turnTimer) resume() {
+	if t.timer != nil {
+		return
+	}
+	t.due = time.Now().Add(t.left)
+	t.timer = time.NewTimer(t.left)
+}
+
+func (t *turnTimer) pause() {
+	if t.timer == nil {
+		return
+	}
+	if !t.timer.Stop() {
+		// the second was over but nobody took the tick yet
+		select {
+		case <-t.timer.C:
+		default:
+		}
+	}
+	t.timer = nil
+	t.left = time.Until(t.due)
+	if t.left <= 0 {
+		t.left = turnTimerInterval
+	}
+}
+
+// next starts the next second after C fired
+func (t *turnTimer) next() {
+	t.due = time.Now().Add(turnTimerInterval)
+	t.timer.Reset(turnTimerInterval)
+}
+
+// stop lets go of the timer when the room closes
+func (t *turnTimer) stop() {
+	t.pause()
+}
+
+// maxTimerSeconds keeps the timers to an hour
+const maxTimerSeconds = 60 * 60
+
+// TimerSettings split a turn of a timed game into the time for the
+// clue and the time for guessing, and give each team a bank of time
+// for the whole game. 0 turns a setting off.
+type TimerSettings struct {
+	ClueSeconds  float64 `json:"clueSeconds"`
+	GuessSeconds float64 `json:"guessSeconds"`
+	BankSeconds  float64 `json:"bankSeconds"`
+}
+
+// deadline fires once at the time it was set to, the router uses it
+// to flip proposals nobody objected to
+type deadline struct {
+	timer *time.Timer
+	at    time.Time
+}
+
+// C is nil while no deadline is set
+func (d *deadline) C() <-chan time.Time {
+	if d.timer == nil {
+		return nil
+	}
+	return d.timer.C
+}
+
+func (d *deadline) set(at time.Time, ok bool) {
+	if !ok {
+		d.stop()
+		return
+	}
+	if d.timer != nil && d.at.Equal(at) {
+		return
+	}
+	d.stop()
+	d.at = at
+	d.timer = time.NewTimer(time.Until(at))
+}
+
+// fired forgets the deadline after C fired
+func (d *deadline) fired() {
+	d.timer = nil
+}
+
+func (d *deadline) stop() {
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+type timerUpdateMessage struct {
+	Timer float64            `json:"timer"`
+	Bank  map[string]float64 `json:"bank,omitempty"`
+}
+
+// ChangeTimerSettings applies to the next game, and right away to a
+// board nobody played on yet.
+func (r *Room) ChangeTimerSettings(playerID string, settings TimerSettings) error {
+	if err := r.authorize(playerID, ActionChangeSettings); err != nil {
+		return err
+	}
+	for _, seconds := range []float64{settings.ClueSeconds, settings.GuessSeconds, settings.BankSeconds} {
+		if seconds < 0 || seconds > maxTimerSeconds {
+			return ErrInvalidSetting
+		}
+	}
+
+	r.Timers = settings
+	if !r.Game.Over && len(r.Game.Log) == 0 {
+		r.applyTimers(r.Game)
+	}
+	return nil
+}
+
+// applyTimers sets the game's clocks from the room's timer settings
+func (r *Room) applyTimers(g *Game) {
+	g.ClueTime = r.Timers.ClueSeconds
+	g.GuessTime = r.Timers.GuessSeconds
+	g.Bank = nil
+	if r.Timers.BankSeconds > 0 {
+		g.Bank = map[string]float64{
+			TeamRed:  r.Timers.BankSeconds,
+			TeamBlue: r.Timers.BankSeconds,
+		}
+	}
+	g.Timer = g.phaseTime()
+}
+
+// phaseTime is what the timer starts from when the clue is being
+// thought of or the guessing starts
+func (g *Game) phaseTime() float64 {
+	if g.Phase != PhaseGuessing && g.ClueTime > 0 {
+		return g.ClueTime
+	}
+	if g.Phase == PhaseGuessing && g.GuessTime > 0 {
+		return g.GuessTime
+	}
+	return g.TimerAmount
+}
+
+// actingTeam is the team whose clock is running, the team giving the
+// clue until there is one and the guessing team after that
+func (r *Room) actingTeam() string {
+	if r.Game.Phase == PhaseAwaitingClue {
+		return r.Game.Turn
+	}
+	return r.guessingTeam()
+}
+
+// timerRunning tells if the room's clock should be counting down
+func (r *Room) timerRunning() bool {
+	return r.Mode == ModeTimed && r.Replay == nil && r.Paused == nil && r.gameStarted() && !r.Game.Over
+}
+
+// TimerTick takes a second off the turn and the bank of the team
+// playing, the turn goes to the other team when the time runs out
+// and a team that used up its bank loses. It tells if the turn ended.
+func (r *Room) TimerTick() bool {
+	if !r.timerRunning() {
+		return false
+	}
+	defer r.checkGameOver(r.Game.Over)
+
+	if r.Game.Bank != nil {
+		team := r.actingTeam()
+		if r.Game.Bank[team] > 0 {
+			r.Game.Bank[team]--
+		}
+		if r.Game.Bank[team] <= 0 {
+			r.outOfTime(team)
+			return true
+		}
+	}
+
+	if r.Game.Timer > 0 {
+		r.Game.Timer--
+	}
+	if r.Game.Timer > 0 {
+		return false
+	}
+
+	r.saveUndo()
+	r.logEvent(nil, GameLog{
+		Event:     "timeout",
+		Team:      r.Game.Turn,
+		EndedTurn: true,
+	})
+	r.switchTurns()
+	return true
+}
+
+// outOfTime ends the game when a team used up its bank, in duet both
+// sides lose together
+func (r *Room) outOfTime(team string) {
+	r.saveUndo()
+	var winner *string
+	if r.Game.GameType == GameTypeClassic {
+		ot := otherTeam(team)
+		winner = &ot
+	}
+	r.Game.end(winner)
+	r.logEvent(nil, GameLog{
+		Event:     "outOfTime",
+		Team:      team,
+		EndedTurn: true,
+	})
+
+	log.WithFields(logrus.Fields{
+		"RoomName": r.Name,
+		"Team":     team,
+	}).Info("team ran out of time")
+}
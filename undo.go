@@ -0,0 +1,134 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxUndo is how many moves of a game can be taken back
+const maxUndo = 10
+
+var ErrNothingToUndo = ActionError{Code: "nothingToUndo", Message: "there is no move to take back"}
+
+type undoState struct {
+	Votes     []string `json:"votes"`
+	Needed    int      `json:"needed"`
+	Available bool     `json:"available"`
+}
+
+// clone copies everything a move can change
+func (g *Game) clone() *Game {
+	c := *g
+	c.Board = copyBoard(g.Board)
+	for i := range c.Board {
+		for j := range c.Board[i] {
+			c.Board[i][j].Bystander = append([]string(nil), g.Board[i][j].Bystander...)
+		}
+	}
+	c.Log = append([]GameLog{}, g.Log...)
+	if g.Clue != nil {
+		clue := *g.Clue
+		c.Clue = &clue
+	}
+	if g.Bank != nil {
+		c.Bank = map[string]float64{}
+		for team, left := range g.Bank {
+			c.Bank[team] = left
+		}
+	}
+	return &c
+}
+
+// saveUndo keeps the game as it is before a move changes it, votes
+// for undoing an earlier move don't count anymore.
+func (r *Room) saveUndo() {
+	r.undo = append(r.undo, r.Game.clone())
+	if len(r.undo) > maxUndo {
+		r.undo = r.undo[len(r.undo)-maxUndo:]
+	}
+	r.undoVotes = nil
+}
+
+// Undo takes back the last move, right away when the host asks and
+// otherwise once most of the players asked for it.
+func (r *Room) Undo(playerID string) error {
+	if err := r.authorize(playerID, ActionUndo); err != nil {
+		return err
+	}
+	if len(r.undo) == 0 {
+		return ErrNothingToUndo
+	}
+
+	if !r.IsHost(playerID) {
+		if r.undoVotes == nil {
+			r.undoVotes = map[string]struct{}{}
+		}
+		r.undoVotes[playerID] = struct{}{}
+		if len(r.undoVotes) < r.undoVotesNeeded() {
+			return nil
+		}
+	}
+
+	if r.Game.Over {
+		r.uncountGame()
+	}
+
+	p := r.Players[playerID]
+	prev := r.undo[len(r.undo)-1]
+	r.undo = r.undo[:len(r.undo)-1]
+	r.undoVotes = nil
+
+	// the log keeps the move so the history is complete, it's only
+	// marked as taken back
+	entries := append([]GameLog{}, r.Game.Log...)
+	for i := len(prev.Log); i < len(entries); i++ {
+		entries[i].Undone = true
+	}
+	r.Game = prev
+	r.Game.Log = entries
+	r.clearGuessProposals()
+	r.logEvent(p, GameLog{
+		Event: "undo",
+		Team:  p.Team,
+	})
+
+	log.WithFields(logrus.Fields{
+		"PlayerID": playerID,
+		"RoomName": r.Name,
+	}).Info("took back the last move")
+	return nil
+}
+
+// undoVotesNeeded is a majority of the players in the room, spectators
+// don't vote
+func (r *Room) undoVotesNeeded() int {
+	players := 0
+	for _, p := range r.Players {
+		if p.Role != PlayerRoleSpectator {
+			players++
+		}
+	}
+	return players/2 + 1
+}
+
+// clearUndo forgets the moves of the last game
+func (r *Room) clearUndo() {
+	r.undo = nil
+	r.undoVotes = nil
+}
+
+func (r *Room) undoProgress() undoState {
+	votes := []string{}
+	for id := range r.undoVotes {
+		if p, ok := r.Player(id); ok {
+			votes = append(votes, p.NickName)
+		}
+	}
+	sort.Strings(votes)
+	return undoState{
+		Votes:     votes,
+		Needed:    r.undoVotesNeeded(),
+		Available: len(r.undo) > 0,
+	}
+}
@@ -0,0 +1,901 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// The /ws endpoint is a plain websocket alternative to socket.io for bots
+// and tools. Every text frame is one JSON envelope:
+//
+//	{"v": 1, "type": "clickTile", "id": "42", "payload": {"i": 0, "j": 3}}
+//
+// Requests use the types in wsHandlers and an id picked by the client, the
+// server answers every request with a "response" envelope carrying the same
+// id. Pushes like "gameState" or "chatMessage" are sent without an id. The
+// version can be left out, it defaults to the current one.
+const wsProtocolVersion = 1
+
+const (
+	wsSendBuffer   = 64
+	wsMaxMessage   = 64 * 1024
+	wsWriteTimeout = 10 * time.Second
+	wsPongTimeout  = 60 * time.Second
+	wsPingInterval = 30 * time.Second
+)
+
+var (
+	ErrUnknownRequest     = ActionError{Code: "unknownRequest", Message: "unknown request type"}
+	ErrBadPayload         = ActionError{Code: "badPayload", Message: "the payload doesn't match the request"}
+	ErrUnsupportedVersion = ActionError{Code: "unsupportedVersion", Message: "unsupported protocol version"}
+	ErrJoinRefused        = ActionError{Code: "joinRefused", Message: "cannot join room"}
+	ErrInvalidNickname    = ActionError{Code: "invalidNickname", Message: "invalid nickname"}
+)
+
+type wsEnvelope struct {
+	Version int             `json:"v"`
+	Type    string          `json:"type"`
+	ID      string          `json:"id,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type wsResponse struct {
+	Success bool                `json:"success"`
+	Error   *actionErrorMessage `json:"error,omitempty"`
+	Data    interface{}         `json:"data,omitempty"`
+}
+
+// wsHello greets a client, the session id is the secret to reconnect
+// with and the player id is how the rest of the room knows the player.
+type wsHello struct {
+	SessionID        string    `json:"sessionId"`
+	PlayerID         string    `json:"playerId"`
+	Players          int       `json:"players"`
+	Rooms            int       `json:"rooms"`
+	IsExistingPlayer bool      `json:"isExistingPlayer"`
+	GameState        gameState `json:"gameState,omitempty"`
+}
+
+// wsReply answers a request, only the first call is sent
+type wsReply func(data interface{}, err error)
+
+type wsHandler func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error
+
+type wsClient struct {
+	playerID string
+	addr     string
+	conn     *websocket.Conn
+	send     chan []byte
+	done     chan struct{}
+	once     sync.Once
+}
+
+// push queues an envelope for the client, a client that can't keep
+// up is dropped instead of holding up the room.
+func (c *wsClient) push(typ, id string, payload interface{}) {
+	data, err := json.Marshal(struct {
+		Version int         `json:"v"`
+		Type    string      `json:"type"`
+		ID      string      `json:"id,omitempty"`
+		Payload interface{} `json:"payload,omitempty"`
+	}{wsProtocolVersion, typ, id, payload})
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"PlayerID": c.playerID,
+			"Type":     typ,
+			"Error":    err,
+		}).Warn("unable to encode websocket message")
+		return
+	}
+
+	select {
+	case <-c.done:
+	case c.send <- data:
+	default:
+		log.WithField("PlayerID", c.playerID).Warn("websocket client is too slow, dropping it")
+		c.close()
+	}
+}
+
+func (c *wsClient) close() {
+	c.once.Do(func() { close(c.done) })
+}
+
+func (c *wsClient) writePump() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case data := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+type wsServer struct {
+	sync.RWMutex
+	router   *ActionRouter
+	upgrader websocket.Upgrader
+	clients  map[string]map[*wsClient]struct{}
+}
+
+func newWsServer(a *ActionRouter) *wsServer {
+	s := &wsServer{
+		router:  a,
+		clients: map[string]map[*wsClient]struct{}{},
+	}
+	a.AddNotifier(s)
+	return s
+}
+
+func (s *wsServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token, playerID := connectSession(req.URL.Query().Get("sessionId"))
+
+	conn, err := s.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		log.WithField("Error", err).Warn("websocket upgrade failed")
+		return
+	}
+
+	c := &wsClient{
+		playerID: playerID,
+		addr:     remoteHost(req.RemoteAddr),
+		conn:     conn,
+		send:     make(chan []byte, wsSendBuffer),
+		done:     make(chan struct{}),
+	}
+	s.register(c)
+	go c.writePump()
+
+	log.WithField("PlayerID", playerID).Info("connected websocket client")
+
+	a := s.router
+	a.CheckIfPlayerExists(playerID, func(players, rooms int, playerID string, isInRoom bool, gs gameState) {
+		c.push("hello", "", wsHello{
+			SessionID:        token,
+			PlayerID:         playerID,
+			Players:          players,
+			Rooms:            rooms,
+			IsExistingPlayer: isInRoom,
+			GameState:        gs,
+		})
+	})
+	a.Reconnect(playerID, func(r *Room) {
+		c.push("chatHistory", "", r.ChatHistoryFor(playerID))
+		a.notifier.RoomUpdated(r)
+	})
+
+	s.readLoop(c)
+
+	c.close()
+	if s.unregister(c) {
+		log.WithField("PlayerID", playerID).Info("closed websocket connection")
+		a.Disconnect(playerID)
+	}
+}
+
+func (s *wsServer) readLoop(c *wsClient) {
+	c.conn.SetReadLimit(wsMaxMessage)
+	c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+
+		var env wsEnvelope
+		if err := json.Unmarshal(data, &env); err != nil {
+			c.push("response", "", wsResponse{Error: wsError("", ErrBadPayload)})
+			continue
+		}
+		s.handle(c, env)
+	}
+}
+
+func (s *wsServer) handle(c *wsClient, env wsEnvelope) {
+	var once sync.Once
+	reply := func(data interface{}, err error) {
+		once.Do(func() {
+			res := wsResponse{Success: err == nil, Data: data}
+			if err != nil {
+				res.Data = nil
+				res.Error = wsError(env.Type, err)
+			}
+			c.push("response", env.ID, res)
+		})
+	}
+
+	log.WithFields(logrus.Fields{
+		"Operation": env.Type,
+		"PlayerID":  c.playerID,
+		"RequestID": env.ID,
+	}).Info("received websocket request")
+
+	if env.Version != 0 && env.Version != wsProtocolVersion {
+		reply(nil, ErrUnsupportedVersion)
+		return
+	}
+	handler, ok := wsHandlers[env.Type]
+	if !ok {
+		reply(nil, ErrUnknownRequest)
+		return
+	}
+	if err := handler(s, c, env.Payload, reply); err != nil {
+		reply(nil, err)
+	}
+}
+
+func (s *wsServer) register(c *wsClient) {
+	s.Lock()
+	defer s.Unlock()
+	if s.clients[c.playerID] == nil {
+		s.clients[c.playerID] = map[*wsClient]struct{}{}
+	}
+	s.clients[c.playerID][c] = struct{}{}
+}
+
+// unregister forgets the client, it tells if it was the player's last connection
+func (s *wsServer) unregister(c *wsClient) bool {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.clients[c.playerID], c)
+	if len(s.clients[c.playerID]) > 0 {
+		return false
+	}
+	delete(s.clients, c.playerID)
+	return true
+}
+
+func (s *wsServer) playerClients(playerID string) []*wsClient {
+	s.RLock()
+	defer s.RUnlock()
+	clients := []*wsClient{}
+	for c := range s.clients[playerID] {
+		clients = append(clients, c)
+	}
+	return clients
+}
+
+func (s *wsServer) RoomUpdated(r *Room) {
+	for id := range r.Players {
+		for _, c := range s.playerClients(id) {
+			c.push("gameState", "", r.GameStateFor(id))
+		}
+	}
+}
+
+func (s *wsServer) RoomEvent(r *Room, event string, args ...interface{}) {
+	for id := range r.Players {
+		for _, c := range s.playerClients(id) {
+			c.push(event, "", eventPayload(args))
+		}
+	}
+}
+
+func (s *wsServer) PlayerEvent(r *Room, playerID, event string, args ...interface{}) {
+	for _, c := range s.playerClients(playerID) {
+		c.push(event, "", eventPayload(args))
+	}
+}
+
+func (s *wsServer) PlayerRemoved(r *Room, playerID string) {
+	for _, c := range s.playerClients(playerID) {
+		c.push("reset", "", nil)
+	}
+}
+
+func (s *wsServer) ChatMessage(r *Room, msg ChatMessage) {
+	for id, p := range r.Players {
+		if !msg.VisibleTo(p) {
+			continue
+		}
+		for _, c := range s.playerClients(id) {
+			c.push("chatMessage", "", msg)
+		}
+	}
+}
+
+// inRoom runs the action in the client's room and answers the request,
+// everyone in the room gets the new state when the action went through.
+func (s *wsServer) inRoom(c *wsClient, reply wsReply, action func(r *Room) error) {
+	ok := s.router.RoomForPlayer(c.playerID, func(r *Room) {
+		if err := action(r); err != nil {
+			reply(nil, err)
+			return
+		}
+		reply(nil, nil)
+		s.router.notifier.RoomUpdated(r)
+	})
+	if !ok {
+		reply(nil, ErrNotInRoom)
+	}
+}
+
+func wsError(action string, err error) *actionErrorMessage {
+	msg := newActionErrorMessage(action, err)
+	return &msg
+}
+
+// eventPayload turns socket.io style event arguments into a single payload
+func eventPayload(args []interface{}) interface{} {
+	switch len(args) {
+	case 0:
+		return nil
+	case 1:
+		return args[0]
+	}
+	return args
+}
+
+func decodePayload(payload json.RawMessage, v interface{}) error {
+	if len(payload) == 0 {
+		return nil
+	}
+	err := json.Unmarshal(payload, v)
+	switch err.(type) {
+	case nil:
+		return nil
+	case ClueError, ActionError:
+		return err
+	}
+	return ErrBadPayload
+}
+
+var wsHandlers = map[string]wsHandler{
+	"createRoom": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Room     string `json:"room"`
+			Nickname string `json:"nickname"`
+			Password string `json:"password"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+
+		a := s.router
+		a.CreateRoom(c.playerID, c.addr, req.Nickname, req.Room, req.Password, ResEmitFunc(func(msg string, success bool) {
+			if !success {
+				reply(nil, ActionError{Code: "createFailed", Message: msg})
+				return
+			}
+			reply(nil, nil)
+			a.RoomForPlayer(c.playerID, a.notifier.RoomUpdated)
+		}))
+		return nil
+	},
+
+	"createReplayRoom": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Room     string      `json:"room"`
+			Nickname string      `json:"nickname"`
+			Password string      `json:"password"`
+			Game     *GameRecord `json:"game"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+
+		a := s.router
+		a.CreateReplayRoom(c.playerID, c.addr, req.Nickname, req.Room, req.Password, req.Game, ResEmitFunc(func(msg string, success bool) {
+			if !success {
+				reply(nil, ActionError{Code: "createFailed", Message: msg})
+				return
+			}
+			reply(nil, nil)
+			a.RoomForPlayer(c.playerID, a.notifier.RoomUpdated)
+		}))
+		return nil
+	},
+
+	"joinRoom": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Room     string `json:"room"`
+			Nickname string `json:"nickname"`
+			Password string `json:"password"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		if len(req.Nickname) == 0 {
+			return ErrInvalidNickname
+		}
+
+		a := s.router
+		ok := a.JoinRoom(c.playerID, c.addr, req.Nickname, req.Room, req.Password, func(r *Room) {
+			if r == nil {
+				reply(nil, ErrJoinRefused)
+				return
+			}
+			reply(nil, nil)
+			c.push("chatHistory", "", r.ChatHistoryFor(c.playerID))
+			a.notifier.RoomUpdated(r)
+		})
+		if !ok {
+			return ErrRoomNotFound
+		}
+		return nil
+	},
+
+	"leaveRoom": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		if !s.router.LeaveRoom(c.playerID, s.router.notifier.RoomUpdated) {
+			return ErrNotInRoom
+		}
+		reply(nil, nil)
+		return nil
+	},
+
+	"gameState": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		if !s.router.RoomForPlayer(c.playerID, func(r *Room) {
+			reply(r.GameStateFor(c.playerID), nil)
+		}) {
+			return ErrNotInRoom
+		}
+		return nil
+	},
+
+	"joinTeam": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Team     string `json:"team"`
+			PlayerID string `json:"playerId"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			if req.PlayerID != "" && req.PlayerID != c.playerID {
+				return r.AssignTeam(c.playerID, req.PlayerID, req.Team)
+			}
+			return r.ChangeTeam(c.playerID, req.Team)
+		})
+		return nil
+	},
+
+	"switchRole": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Role     string `json:"role"`
+			PlayerID string `json:"playerId"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			if req.PlayerID != "" && req.PlayerID != c.playerID {
+				return r.AssignRole(c.playerID, req.PlayerID, req.Role)
+			}
+			return r.SwitchRole(c.playerID, req.Role)
+		})
+		return nil
+	},
+
+	"randomizeTeams": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.RandomizeTeams(c.playerID)
+		})
+		return nil
+	},
+
+	"newGame": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Code string `json:"code"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			if len(req.Code) > 0 {
+				return r.NewGameFromCode(c.playerID, req.Code)
+			}
+			return r.NewGame(c.playerID)
+		})
+		return nil
+	},
+
+	"switchDifficulty": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Difficulty string `json:"difficulty"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeDifficulty(c.playerID, req.Difficulty)
+		})
+		return nil
+	},
+
+	"switchMode": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Mode string `json:"mode"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SwitchMode(c.playerID, req.Mode)
+		})
+		return nil
+	},
+
+	"switchGameType": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			GameType string `json:"gameType"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SwitchGameType(c.playerID, req.GameType)
+		})
+		return nil
+	},
+
+	"switchConsensus": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Consensus string `json:"consensus"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SwitchConsensus(c.playerID, req.Consensus)
+		})
+		return nil
+	},
+
+	"consensusSettings": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req ConsensusSettings
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeConsensusSettings(c.playerID, req)
+		})
+		return nil
+	},
+
+	"withdrawProposal": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.WithdrawProposal(c.playerID)
+		})
+		return nil
+	},
+
+	"endTurn": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.EndTurn(c.playerID)
+		})
+		return nil
+	},
+
+	"seriesSettings": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req SeriesSettings
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeSeriesSettings(c.playerID, req)
+		})
+		return nil
+	},
+
+	"rolePolicy": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Policy string `json:"policy"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeRolePolicy(c.playerID, req.Policy)
+		})
+		return nil
+	},
+
+	"resetSeries": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ResetSeries(c.playerID)
+		})
+		return nil
+	},
+
+	"ready": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Ready bool `json:"ready"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SetReady(c.playerID, req.Ready)
+		})
+		return nil
+	},
+
+	"startGame": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ForceStart(c.playerID)
+		})
+		return nil
+	},
+
+	"pauseGame": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.PauseGame(c.playerID)
+		})
+		return nil
+	},
+
+	"resumeGame": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ResumeGame(c.playerID)
+		})
+		return nil
+	},
+
+	"leaderboard": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Scope string `json:"scope"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		if _, ok := StatsScopes[req.Scope]; !ok {
+			return ErrInvalidSetting
+		}
+		if req.Scope == StatsScopeServer {
+			reply(s.router.stats.Leaderboard(""), nil)
+			return nil
+		}
+		if !s.router.RoomForPlayer(c.playerID, func(r *Room) {
+			reply(s.router.stats.Leaderboard(r.Name), nil)
+		}) {
+			return ErrNotInRoom
+		}
+		return nil
+	},
+
+	"undo": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.Undo(c.playerID)
+		})
+		return nil
+	},
+
+	"clickTile": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			I int `json:"i"`
+			J int `json:"j"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SelectTile(c.playerID, req.I, req.J)
+		})
+		return nil
+	},
+
+	"declareClue": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		// the count can be a number or "unlimited", same as in the game state
+		var clue Clue
+		if err := decodePayload(payload, &clue); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.DeclareClue(c.playerID, clue.Word, clue.Count)
+		})
+		return nil
+	},
+
+	"setClueRule": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Rule    string `json:"rule"`
+			Enabled bool   `json:"enabled"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SetClueRule(c.playerID, req.Rule, req.Enabled)
+		})
+		return nil
+	},
+
+	"changeCards": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Pack string `json:"pack"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeCards(c.playerID, req.Pack)
+		})
+		return nil
+	},
+
+	"uploadWordPack": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Name  string   `json:"name"`
+			Words []string `json:"words"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			_, err := r.UploadWordPack(c.playerID, req.Name, req.Words)
+			return err
+		})
+		return nil
+	},
+
+	"exportGame": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		if !s.router.RoomForPlayer(c.playerID, func(r *Room) {
+			rec, err := r.ExportGame(c.playerID)
+			if err != nil {
+				reply(nil, err)
+				return
+			}
+			reply(rec, nil)
+		}) {
+			return ErrNotInRoom
+		}
+		return nil
+	},
+
+	"replayStep": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Step int `json:"step"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ReplayStep(c.playerID, req.Step)
+		})
+		return nil
+	},
+
+	"setPackWeight": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Pack    string `json:"pack"`
+			Percent int    `json:"percent"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.SetPackWeight(c.playerID, req.Pack, req.Percent)
+		})
+		return nil
+	},
+
+	"changeTimer": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Minutes float64 `json:"minutes"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeTimer(c.playerID, req.Minutes)
+		})
+		return nil
+	},
+
+	"timerSettings": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req TimerSettings
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.ChangeTimerSettings(c.playerID, req)
+		})
+		return nil
+	},
+
+	"kickPlayer": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		return wsKick(s, c, payload, reply, false)
+	},
+
+	"banPlayer": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		return wsKick(s, c, payload, reply, true)
+	},
+
+	"transferHost": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			PlayerID string `json:"playerId"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.TransferHost(c.playerID, req.PlayerID)
+		})
+		return nil
+	},
+
+	"lockSettings": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Locked bool `json:"locked"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		s.inRoom(c, reply, func(r *Room) error {
+			return r.LockSettings(c.playerID, req.Locked)
+		})
+		return nil
+	},
+
+	"chat": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		var req struct {
+			Scope string `json:"scope"`
+			Text  string `json:"text"`
+		}
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		if !s.router.RoomForPlayer(c.playerID, func(r *Room) {
+			msg, err := r.SendChat(c.playerID, req.Scope, req.Text)
+			reply(msg, err)
+			if err == nil {
+				s.router.notifier.ChatMessage(r, msg)
+			}
+		}) {
+			return ErrNotInRoom
+		}
+		return nil
+	},
+
+	"active": func(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply) error {
+		// routing any action through the room resets the afk timer
+		if !s.router.RoomForPlayer(c.playerID, func(r *Room) { reply(nil, nil) }) {
+			return ErrNotInRoom
+		}
+		return nil
+	},
+}
+
+func wsKick(s *wsServer, c *wsClient, payload json.RawMessage, reply wsReply, ban bool) error {
+	var req struct {
+		PlayerID string `json:"playerId"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return err
+	}
+	a := s.router
+	if !a.Kick(c.playerID, req.PlayerID, ban, func(r *Room, err error) {
+		reply(nil, err)
+		if err == nil {
+			a.notifier.RoomUpdated(r)
+		}
+	}) {
+		return ErrNotInRoom
+	}
+	return nil
+}
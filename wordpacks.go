@@ -0,0 +1,363 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// a pack has to fill a whole board on its own
+	minPackWords  = 25
+	maxPackWords  = 2000
+	maxWordLength = 32
+	maxRoomPacks  = 5
+
+	roomPackPrefix = "room:"
+	defaultPackID  = "base"
+)
+
+var (
+	ErrUnknownPack   = ActionError{Code: "unknownPack", Message: "unknown word pack"}
+	ErrPackName      = ActionError{Code: "invalidPackName", Message: "word packs need a name"}
+	ErrPackTooSmall  = ActionError{Code: "packTooSmall", Message: fmt.Sprintf("a word pack needs at least %d different words", minPackWords)}
+	ErrPackTooLarge  = ActionError{Code: "packTooLarge", Message: fmt.Sprintf("a word pack can have at most %d words", maxPackWords)}
+	ErrWordTooLong   = ActionError{Code: "wordTooLong", Message: fmt.Sprintf("words can be at most %d characters long", maxWordLength)}
+	ErrTooManyPacks  = ActionError{Code: "tooManyPacks", Message: fmt.Sprintf("a room can have at most %d word packs of its own", maxRoomPacks)}
+	ErrPackWeight    = ActionError{Code: "invalidWeight", Message: "a pack's share has to be between 0 and 100 percent"}
+	ErrNoPackWeight  = ActionError{Code: "noWeight", Message: "at least one selected pack needs a share of the board"}
+	ErrWeightTotal   = ActionError{Code: "weightTotal", Message: "the packs' shares add up to more than 100 percent"}
+	ErrWordsRanOut   = ActionError{Code: "notEnoughWords", Message: "the word packs don't have enough words for a board"}
+	errInvalidPackID = fmt.Errorf("pack ids can only have lower case letters, digits, - and _")
+)
+
+var packIDPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// WordPack is a named list of words boards are dealt from
+type WordPack struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Words []string `json:"words"`
+}
+
+// WordPackInfo describes a pack without its words, it's what clients get
+type WordPackInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+func (p *WordPack) Info() WordPackInfo {
+	return WordPackInfo{
+		ID:   p.ID,
+		Name: p.Name,
+		Size: len(p.Words),
+	}
+}
+
+// NewWordPack checks and cleans up the words, they are upper cased
+// and empty lines and duplicates are dropped.
+func NewWordPack(id, name string, words []string) (*WordPack, error) {
+	if len(words) > maxPackWords {
+		return nil, ErrPackTooLarge
+	}
+
+	seen := map[string]struct{}{}
+	cleaned := []string{}
+	for _, w := range words {
+		w = strings.ToUpper(strings.TrimSpace(w))
+		if len(w) == 0 {
+			continue
+		}
+		if len(w) > maxWordLength {
+			return nil, ErrWordTooLong
+		}
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		cleaned = append(cleaned, w)
+	}
+	if len(cleaned) < minPackWords {
+		return nil, ErrPackTooSmall
+	}
+
+	return &WordPack{
+		ID:    id,
+		Name:  name,
+		Words: cleaned,
+	}, nil
+}
+
+// WordPackRegistry holds the packs every room can pick from
+type WordPackRegistry struct {
+	sync.RWMutex
+	packs map[string]*WordPack
+}
+
+func NewWordPackRegistry() *WordPackRegistry {
+	return &WordPackRegistry{
+		packs: map[string]*WordPack{},
+	}
+}
+
+// wordPacks is the server wide registry, the built in packs are
+// added when the server starts.
+var wordPacks = NewWordPackRegistry()
+
+func (w *WordPackRegistry) Register(p *WordPack) error {
+	if !packIDPattern.MatchString(p.ID) {
+		return errInvalidPackID
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	if _, ok := w.packs[p.ID]; ok {
+		log.WithField("PackID", p.ID).Info("replacing word pack")
+	}
+	w.packs[p.ID] = p
+	return nil
+}
+
+func (w *WordPackRegistry) Get(id string) (*WordPack, bool) {
+	w.RLock()
+	defer w.RUnlock()
+	p, ok := w.packs[id]
+	return p, ok
+}
+
+// List returns the packs sorted by id
+func (w *WordPackRegistry) List() []*WordPack {
+	w.RLock()
+	defer w.RUnlock()
+	packs := []*WordPack{}
+	for _, p := range w.packs {
+		packs = append(packs, p)
+	}
+	sort.Slice(packs, func(i, j int) bool { return packs[i].ID < packs[j].ID })
+	return packs
+}
+
+// LoadDir registers every .txt file in the directory as a pack, one
+// word per line. The file name without extension is the pack's id.
+func (w *WordPackRegistry) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		id := strings.ToLower(strings.TrimSuffix(filepath.Base(file), ".txt"))
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		p, err := NewWordPack(id, id, strings.Split(string(data), "\n"))
+		if err == nil {
+			err = w.Register(p)
+		}
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"File":  file,
+				"Error": err,
+			}).Warn("skipping word pack")
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"PackID": id,
+			"Words":  len(p.Words),
+		}).Info("loaded word pack")
+	}
+	return nil
+}
+
+// UploadWordPack adds a pack only this room can use, uploading a pack
+// with the same name again replaces it.
+func (r *Room) UploadWordPack(playerID, name string, words []string) (*WordPack, error) {
+	if err := r.authorize(playerID, ActionChangeCards); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	id := roomPackPrefix + strings.ToLower(name)
+	if len(name) == 0 || len(name) > maxWordLength {
+		return nil, ErrPackName
+	}
+	if _, ok := r.RoomPacks[id]; !ok && len(r.RoomPacks) >= maxRoomPacks {
+		return nil, ErrTooManyPacks
+	}
+
+	p, err := NewWordPack(id, name, words)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.RoomPacks == nil {
+		r.RoomPacks = map[string]*WordPack{}
+	}
+	r.RoomPacks[id] = p
+
+	log.WithFields(logrus.Fields{
+		"PlayerID": playerID,
+		"RoomName": r.Name,
+		"PackID":   id,
+		"Words":    len(p.Words),
+	}).Info("player uploaded a word pack")
+	return p, nil
+}
+
+// SetPackWeight sets the percentage of the board dealt from a pack,
+// the shares of all packs together can't go over 100 percent.
+func (r *Room) SetPackWeight(playerID, pack string, percent int) error {
+	if err := r.authorize(playerID, ActionChangeCards); err != nil {
+		return err
+	}
+	if _, ok := r.wordPack(pack); !ok {
+		return ErrUnknownPack
+	}
+	if percent < 0 || percent > 100 {
+		return ErrPackWeight
+	}
+
+	weights := map[string]int{}
+	total := percent
+	for id, w := range r.PackWeights {
+		weights[id] = w
+		if id != pack {
+			total += w
+		}
+	}
+	if total > 100 {
+		return ErrWeightTotal
+	}
+	weights[pack] = percent
+	if _, err := packShares(r.selectedPacks(), weights, boardSize); err != nil {
+		return err
+	}
+
+	r.PackWeights = weights
+	return nil
+}
+
+// packShares splits the board between the packs by their percentages,
+// packs without one share what the others leave of 100 percent equally.
+// When every pack has a percentage and they add up to less than 100
+// they are scaled, and the words left over by rounding go to the
+// largest remainders so the shares always add up to the total.
+func packShares(packs []*WordPack, weights map[string]int, total int) ([]int, error) {
+	percents := make([]int, len(packs))
+	unset := []int{}
+	left := 100
+	for i, p := range packs {
+		if w, ok := weights[p.ID]; ok {
+			percents[i] = w
+			left -= w
+		} else {
+			unset = append(unset, i)
+		}
+	}
+	if left < 0 {
+		left = 0
+	}
+	for n, i := range unset {
+		percents[i] = left / len(unset)
+		if n < left%len(unset) {
+			percents[i]++
+		}
+	}
+
+	sum := 0
+	for _, p := range percents {
+		sum += p
+	}
+	if sum == 0 {
+		return nil, ErrNoPackWeight
+	}
+
+	shares := make([]int, len(packs))
+	remainders := make([]int, len(packs))
+	dealt := 0
+	for i, p := range percents {
+		shares[i] = total * p / sum
+		remainders[i] = total * p % sum
+		dealt += shares[i]
+	}
+
+	order := make([]int, len(packs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+	for i := 0; dealt < total; i++ {
+		shares[order[i]]++
+		dealt++
+	}
+	return shares, nil
+}
+
+// wordPack finds a pack in the registry or among the room's own packs
+func (r *Room) wordPack(id string) (*WordPack, bool) {
+	if p, ok := r.RoomPacks[id]; ok {
+		return p, true
+	}
+	return wordPacks.Get(id)
+}
+
+// selectedPacks returns the packs boards are dealt from, the base pack
+// is used when nothing is selected.
+func (r *Room) selectedPacks() []*WordPack {
+	packs := []*WordPack{}
+	for _, id := range r.WordPacks {
+		if p, ok := r.wordPack(id); ok {
+			packs = append(packs, p)
+		}
+	}
+	if len(packs) == 0 {
+		if p, ok := wordPacks.Get(defaultPackID); ok {
+			packs = append(packs, p)
+		}
+	}
+	return packs
+}
+
+// availablePacks lists every pack the room can choose from
+func (r *Room) availablePacks() []WordPackInfo {
+	infos := []WordPackInfo{}
+	for _, p := range wordPacks.List() {
+		infos = append(infos, p.Info())
+	}
+
+	ids := []string{}
+	for id := range r.RoomPacks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		infos = append(infos, r.RoomPacks[id].Info())
+	}
+	return infos
+}
+
+func packIDs(packs []*WordPack) []string {
+	ids := []string{}
+	for _, p := range packs {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func hasPack(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}